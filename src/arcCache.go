@@ -0,0 +1,217 @@
+package godatastructures
+
+import "iter"
+
+type arcEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// ARCCache is a fixed-capacity cache using Adaptive Replacement (Megiddo and
+// Modha). It splits its entries between t1, holding keys seen once recently,
+// and t2, holding keys seen at least twice, and remembers the keys it
+// recently evicted from each in the ghost lists b1 and b2. A Put that hits a
+// ghost shifts the target size of t1, so the cache tunes itself between
+// recency and frequency and is not flushed by a single scan. Every operation
+// is O(1). It is not safe for concurrent use.
+type ARCCache[K comparable, V any] struct {
+	items    map[K]*ListElement[arcEntry[K, V]]
+	t1, t2   *LinkedList[arcEntry[K, V]]
+	b1, b2   *LinkedList[arcEntry[K, V]]
+	target   int
+	capacity int
+	stats    CacheStats
+}
+
+func NewARCCache[K comparable, V any](capacity int) *ARCCache[K, V] {
+	if capacity <= 0 {
+		panic("godatastructures: ARCCache capacity must be positive")
+	}
+	return &ARCCache[K, V]{
+		items:    make(map[K]*ListElement[arcEntry[K, V]]),
+		t1:       NewLinkedList[arcEntry[K, V]](),
+		t2:       NewLinkedList[arcEntry[K, V]](),
+		b1:       NewLinkedList[arcEntry[K, V]](),
+		b2:       NewLinkedList[arcEntry[K, V]](),
+		capacity: capacity,
+	}
+}
+
+// Get returns the value for key and promotes it to the frequently used list.
+func (c *ARCCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.resident(key)
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	return c.moveTo(e, c.t2).Value.value, true
+}
+
+// Peek returns the value for key without promoting it.
+func (c *ARCCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.resident(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.Value.value, true
+}
+
+func (c *ARCCache[K, V]) Contains(key K) bool {
+	_, ok := c.resident(key)
+	return ok
+}
+
+// Put stores value under key, evicting an entry first if the cache is full.
+// Put never fails; it returns an error to satisfy Cache.
+func (c *ARCCache[K, V]) Put(key K, value V) error {
+	e, ok := c.items[key]
+	if ok {
+		switch e.list {
+		case c.t1, c.t2:
+			e.Value.value = value
+			c.moveTo(e, c.t2)
+			return nil
+		case c.b1:
+			c.target = min(c.capacity, c.target+max(c.b2.Size()/c.b1.Size(), 1))
+			c.replace(false)
+		case c.b2:
+			c.target = max(0, c.target-max(c.b1.Size()/c.b2.Size(), 1))
+			c.replace(true)
+		}
+		e.Value.value = value
+		c.moveTo(e, c.t2)
+		return nil
+	}
+
+	switch {
+	case c.t1.Size()+c.b1.Size() >= c.capacity:
+		if c.t1.Size() < c.capacity {
+			c.forget(c.b1)
+			c.replace(false)
+		} else {
+			c.evict(c.t1, nil)
+		}
+	case c.t1.Size()+c.t2.Size()+c.b1.Size()+c.b2.Size() >= c.capacity:
+		if c.t1.Size()+c.t2.Size()+c.b1.Size()+c.b2.Size() >= 2*c.capacity {
+			c.forget(c.b2)
+		}
+		c.replace(false)
+	}
+	c.items[key] = c.t1.PushFront(arcEntry[K, V]{key: key, value: value})
+	return nil
+}
+
+// Remove deletes key and returns its value. A key that is only remembered
+// in a ghost list is forgotten and reported as missing.
+func (c *ARCCache[K, V]) Remove(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	list := e.list
+	list.Remove(e)
+	delete(c.items, key)
+	if list == c.b1 || list == c.b2 {
+		var zero V
+		return zero, false
+	}
+	return e.Value.value, true
+}
+
+// Len returns the number of cached entries, not counting ghost keys.
+func (c *ARCCache[K, V]) Len() int {
+	return c.t1.Size() + c.t2.Size()
+}
+
+func (c *ARCCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+func (c *ARCCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+func (c *ARCCache[K, V]) Clear() {
+	for _, list := range []*LinkedList[arcEntry[K, V]]{c.t1, c.t2, c.b1, c.b2} {
+		list.Clear()
+	}
+	clear(c.items)
+	c.target = 0
+}
+
+// All yields the cached entries: first those seen once, then those seen
+// more often, each from most to least recently used.
+func (c *ARCCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, list := range []*LinkedList[arcEntry[K, V]]{c.t1, c.t2} {
+			for e := list.Front(); e != nil; e = e.Next() {
+				if !yield(e.Value.key, e.Value.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func (c *ARCCache[K, V]) resident(key K) (*ListElement[arcEntry[K, V]], bool) {
+	e, ok := c.items[key]
+	if !ok || (e.list != c.t1 && e.list != c.t2) {
+		return nil, false
+	}
+	return e, true
+}
+
+// replace makes room for one entry by demoting the least recently used
+// entry of t1 or t2 to its ghost list, choosing t1 when it is above its
+// target size. inB2 reports whether the incoming key was found in b2.
+func (c *ARCCache[K, V]) replace(inB2 bool) {
+	if c.t1.Size()+c.t2.Size() < c.capacity {
+		return
+	}
+	t1 := c.t1.Size()
+	if t1 > 0 && (t1 > c.target || (inB2 && t1 == c.target) || c.t2.IsEmpty()) {
+		c.evict(c.t1, c.b1)
+	} else {
+		c.evict(c.t2, c.b2)
+	}
+}
+
+// evict removes the least recently used entry of list, remembering its key
+// in ghost unless ghost is nil.
+func (c *ARCCache[K, V]) evict(list, ghost *LinkedList[arcEntry[K, V]]) {
+	e := list.Back()
+	c.stats.Evictions++
+	if ghost == nil {
+		list.Remove(e)
+		delete(c.items, e.Value.key)
+		return
+	}
+	var zero V
+	e.Value.value = zero
+	c.moveTo(e, ghost)
+}
+
+// forget drops the least recently used key of a ghost list.
+func (c *ARCCache[K, V]) forget(ghost *LinkedList[arcEntry[K, V]]) {
+	if e := ghost.Back(); e != nil {
+		ghost.Remove(e)
+		delete(c.items, e.Value.key)
+	}
+}
+
+// moveTo makes e the most recently used element of list and returns its new
+// element.
+func (c *ARCCache[K, V]) moveTo(e *ListElement[arcEntry[K, V]], list *LinkedList[arcEntry[K, V]]) *ListElement[arcEntry[K, V]] {
+	if e.list == list {
+		list.MoveToFront(e)
+		return e
+	}
+	entry, _ := e.list.Remove(e)
+	moved := list.PushFront(entry)
+	c.items[entry.key] = moved
+	return moved
+}
@@ -0,0 +1,94 @@
+package godatastructures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestARCCache(t *testing.T) {
+	t.Run("Basic get and put", func(t *testing.T) {
+		c := NewARCCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		val, ok := c.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+
+		c.Put("c", 3)
+		assert.Equal(t, 2, c.Len())
+		assert.True(t, c.Contains("a"))
+		assert.False(t, c.Contains("b"))
+
+		c.Put("a", 10)
+		val, _ = c.Peek("a")
+		assert.Equal(t, 10, val)
+	})
+
+	t.Run("Frequently used entries survive a scan", func(t *testing.T) {
+		c := NewARCCache[int, int](10)
+		for i := range 5 {
+			c.Put(i, i)
+			c.Get(i)
+		}
+		for i := 100; i < 1000; i++ {
+			c.Put(i, i)
+		}
+		for i := range 5 {
+			assert.True(t, c.Contains(i))
+		}
+		assert.Equal(t, 10, c.Len())
+	})
+
+	t.Run("Ghost hits adapt the target", func(t *testing.T) {
+		c := NewARCCache[int, int](2)
+		c.Put(1, 1)
+		c.Put(2, 2)
+		c.Get(2)
+		c.Put(3, 3)
+		assert.False(t, c.Contains(1))
+		assert.Equal(t, 1, c.b1.Size())
+		_, ok := c.Get(1)
+		assert.False(t, ok)
+
+		c.Put(1, 1)
+		assert.Equal(t, 1, c.target)
+		val, ok := c.Get(1)
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+		assert.True(t, c.Contains(3))
+		assert.False(t, c.Contains(2))
+		assert.Equal(t, 1, c.b2.Size())
+	})
+
+	t.Run("Bounded directory", func(t *testing.T) {
+		c := NewARCCache[int, int](4)
+		for i := range 1000 {
+			c.Put(i%37, i)
+			if i%3 == 0 {
+				c.Get(i % 11)
+			}
+			assert.LessOrEqual(t, c.Len(), 4)
+			assert.LessOrEqual(t, len(c.items), 8)
+		}
+	})
+
+	t.Run("Remove forgets resident and ghost keys", func(t *testing.T) {
+		c := NewARCCache[int, int](1)
+		c.Put(1, 1)
+		c.Put(2, 2)
+		_, ok := c.Remove(1)
+		assert.False(t, ok)
+		assert.Equal(t, 0, c.b1.Size())
+
+		val, ok := c.Remove(2)
+		assert.True(t, ok)
+		assert.Equal(t, 2, val)
+		assert.Equal(t, 0, c.Len())
+
+		c.Put(3, 3)
+		c.Clear()
+		assert.Equal(t, 0, c.Len())
+		assert.Empty(t, c.items)
+	})
+}
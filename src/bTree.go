@@ -0,0 +1,501 @@
+package godatastructures
+
+import (
+	"iter"
+	"slices"
+)
+
+type bTreeItem[K, V any] struct {
+	key   K
+	value V
+}
+
+// bTreeOwner identifies the tree allowed to modify a node in place. Nodes
+// owned by another tree are copied before being written, which is what makes
+// Clone cheap. It is not zero-sized so that every owner has its own address.
+type bTreeOwner struct {
+	_ byte
+}
+
+// bTreeNode keeps its items, and the children between them, in contiguous
+// slices. A leaf has no children; an internal node has len(items)+1.
+type bTreeNode[K, V any] struct {
+	items    []bTreeItem[K, V]
+	children []*bTreeNode[K, V]
+	owner    *bTreeOwner
+}
+
+// BTree is an ordered map stored as a B-tree of minimum degree Degree: every
+// node except the root holds between Degree-1 and 2*Degree-1 entries in a
+// single slice, which keeps the tree shallow and cuts the number of pointers
+// the garbage collector has to trace. Clone returns a copy-on-write snapshot
+// in O(1).
+type BTree[K, V any] struct {
+	root    *bTreeNode[K, V]
+	size    int
+	degree  int
+	compare func(a, b K) int
+	owner   *bTreeOwner
+}
+
+// NewBTree returns an empty tree with the given minimum degree, which must
+// be at least 2. Larger degrees give wider nodes and a shallower tree.
+func NewBTree[K, V any](degree int, compare func(a, b K) int) *BTree[K, V] {
+	if degree < 2 {
+		panic("godatastructures: BTree degree must be at least 2")
+	}
+	return &BTree[K, V]{degree: degree, compare: compare, owner: &bTreeOwner{}}
+}
+
+// BTreeFromSorted builds a tree from entries in strictly ascending key order
+// in O(n), packing nodes instead of inserting one entry at a time. It
+// returns ErrUnsorted if the keys are out of order or repeated.
+func BTreeFromSorted[K, V any](seq iter.Seq2[K, V], degree int, compare func(a, b K) int) (*BTree[K, V], error) {
+	t := NewBTree[K, V](degree, compare)
+	var items []bTreeItem[K, V]
+	for key, value := range seq {
+		if len(items) > 0 && compare(items[len(items)-1].key, key) >= 0 {
+			return nil, ErrUnsorted
+		}
+		items = append(items, bTreeItem[K, V]{key: key, value: value})
+	}
+	if len(items) == 0 {
+		return t, nil
+	}
+	height := 1
+	for t.capacity(height) < len(items) {
+		height++
+	}
+	t.root = t.build(items, height)
+	t.size = len(items)
+	return t, nil
+}
+
+// capacity returns how many entries fit in a full subtree of the given
+// height.
+func (t *BTree[K, V]) capacity(height int) int {
+	fanout := 2 * t.degree
+	total := 1
+	for range height {
+		total *= fanout
+	}
+	return total - 1
+}
+
+// build packs items into a subtree of exactly the given height, spreading
+// them evenly across as few children as possible.
+func (t *BTree[K, V]) build(items []bTreeItem[K, V], height int) *bTreeNode[K, V] {
+	node := &bTreeNode[K, V]{owner: t.owner}
+	if height == 1 {
+		node.items = slices.Clone(items)
+		return node
+	}
+	childCapacity := t.capacity(height - 1)
+	children := max(2, (len(items)+1+childCapacity)/(childCapacity+1))
+	perChild := len(items) - (children - 1)
+	node.items = make([]bTreeItem[K, V], 0, children-1)
+	node.children = make([]*bTreeNode[K, V], 0, children)
+	start := 0
+	for i := range children {
+		count := perChild / children
+		if i < perChild%children {
+			count++
+		}
+		node.children = append(node.children, t.build(items[start:start+count], height-1))
+		start += count
+		if i < children-1 {
+			node.items = append(node.items, items[start])
+			start++
+		}
+	}
+	return node
+}
+
+func (t *BTree[K, V]) Size() int {
+	return t.size
+}
+
+func (t *BTree[K, V]) IsEmpty() bool {
+	return t.size == 0
+}
+
+func (t *BTree[K, V]) Degree() int {
+	return t.degree
+}
+
+func (t *BTree[K, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Clone returns a snapshot of the tree in O(1). The two trees share nodes
+// until one of them writes to a node, at which point that tree copies it.
+// Clone must not run concurrently with writes to t, but afterwards the
+// clone can be read by other goroutines while t keeps being modified.
+func (t *BTree[K, V]) Clone() *BTree[K, V] {
+	clone := *t
+	t.owner = &bTreeOwner{}
+	clone.owner = &bTreeOwner{}
+	return &clone
+}
+
+func (t *BTree[K, V]) Get(key K) (V, bool) {
+	node := t.root
+	for node != nil {
+		i, found := t.find(node, key)
+		if found {
+			return node.items[i].value, true
+		}
+		if len(node.children) == 0 {
+			break
+		}
+		node = node.children[i]
+	}
+	var zero V
+	return zero, false
+}
+
+func (t *BTree[K, V]) Contains(key K) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Put stores value under key and reports whether the key was new.
+func (t *BTree[K, V]) Put(key K, value V) bool {
+	item := bTreeItem[K, V]{key: key, value: value}
+	if t.root == nil {
+		t.root = &bTreeNode[K, V]{owner: t.owner, items: []bTreeItem[K, V]{item}}
+		t.size++
+		return true
+	}
+	t.root = t.mutable(t.root)
+	if len(t.root.items) >= t.maxItems() {
+		middle, right := t.split(t.root, t.degree-1)
+		t.root = &bTreeNode[K, V]{
+			owner:    t.owner,
+			items:    []bTreeItem[K, V]{middle},
+			children: []*bTreeNode[K, V]{t.root, right},
+		}
+	}
+	added := t.insert(t.root, item)
+	if added {
+		t.size++
+	}
+	return added
+}
+
+// Delete removes key and returns its value, if it was present.
+func (t *BTree[K, V]) Delete(key K) (V, bool) {
+	if t.root == nil {
+		var zero V
+		return zero, false
+	}
+	t.root = t.mutable(t.root)
+	item, ok := t.remove(t.root, key, bTreeRemoveKey)
+	t.shrinkRoot()
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	t.size--
+	return item.value, true
+}
+
+// DeleteRange removes every entry with lo <= key < hi and returns how many
+// were removed. It costs O(log n) per removed entry.
+func (t *BTree[K, V]) DeleteRange(lo, hi K) int {
+	var keys []K
+	for key := range t.Range(lo, hi) {
+		keys = append(keys, key)
+	}
+	for _, key := range keys {
+		t.Delete(key)
+	}
+	return len(keys)
+}
+
+// Min returns the smallest key and its value.
+func (t *BTree[K, V]) Min() (K, V, bool) {
+	node := t.root
+	if node == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	for len(node.children) > 0 {
+		node = node.children[0]
+	}
+	return node.items[0].key, node.items[0].value, true
+}
+
+// Max returns the largest key and its value.
+func (t *BTree[K, V]) Max() (K, V, bool) {
+	node := t.root
+	if node == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	for len(node.children) > 0 {
+		node = node.children[len(node.children)-1]
+	}
+	last := node.items[len(node.items)-1]
+	return last.key, last.value, true
+}
+
+// All yields every entry in ascending key order.
+func (t *BTree[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, nil, nil, yield)
+	}
+}
+
+// Keys yields every key in ascending order.
+func (t *BTree[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range t.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Range yields the entries with lo <= key < hi in ascending order.
+func (t *BTree[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.ascend(t.root, &lo, &hi, yield)
+	}
+}
+
+// Backward yields every entry in descending key order.
+func (t *BTree[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		t.descend(t.root, yield)
+	}
+}
+
+// ascend walks node in order from lo up to hi, where a nil bound is
+// unbounded, and reports whether the walk should continue.
+func (t *BTree[K, V]) ascend(node *bTreeNode[K, V], lo, hi *K, yield func(K, V) bool) bool {
+	if node == nil {
+		return true
+	}
+	start := 0
+	if lo != nil {
+		start, _ = t.find(node, *lo)
+	}
+	for i := start; i < len(node.items); i++ {
+		if len(node.children) > 0 && !t.ascend(node.children[i], lo, hi, yield) {
+			return false
+		}
+		item := node.items[i]
+		if hi != nil && t.compare(item.key, *hi) >= 0 {
+			return false
+		}
+		if !yield(item.key, item.value) {
+			return false
+		}
+	}
+	if len(node.children) > 0 {
+		return t.ascend(node.children[len(node.children)-1], lo, hi, yield)
+	}
+	return true
+}
+
+func (t *BTree[K, V]) descend(node *bTreeNode[K, V], yield func(K, V) bool) bool {
+	if node == nil {
+		return true
+	}
+	for i := len(node.items); i >= 0; i-- {
+		if len(node.children) > 0 && !t.descend(node.children[i], yield) {
+			return false
+		}
+		if i > 0 && !yield(node.items[i-1].key, node.items[i-1].value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (t *BTree[K, V]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+func (t *BTree[K, V]) minItems() int {
+	return t.degree - 1
+}
+
+// find returns the position of key in node, or where it would be inserted.
+func (t *BTree[K, V]) find(node *bTreeNode[K, V], key K) (int, bool) {
+	return slices.BinarySearchFunc(node.items, key, func(item bTreeItem[K, V], key K) int {
+		return t.compare(item.key, key)
+	})
+}
+
+// mutable returns node if t owns it, or a copy owned by t otherwise.
+func (t *BTree[K, V]) mutable(node *bTreeNode[K, V]) *bTreeNode[K, V] {
+	if node.owner == t.owner {
+		return node
+	}
+	copied := &bTreeNode[K, V]{
+		owner: t.owner,
+		items: slices.Clone(node.items),
+	}
+	if len(node.children) > 0 {
+		copied.children = slices.Clone(node.children)
+	}
+	return copied
+}
+
+func (t *BTree[K, V]) mutableChild(node *bTreeNode[K, V], i int) *bTreeNode[K, V] {
+	child := t.mutable(node.children[i])
+	node.children[i] = child
+	return child
+}
+
+// split moves everything after items[i] into a new right sibling and
+// returns items[i] along with it.
+func (t *BTree[K, V]) split(node *bTreeNode[K, V], i int) (bTreeItem[K, V], *bTreeNode[K, V]) {
+	middle := node.items[i]
+	right := &bTreeNode[K, V]{owner: t.owner}
+	right.items = append(right.items, node.items[i+1:]...)
+	clear(node.items[i:])
+	node.items = node.items[:i]
+	if len(node.children) > 0 {
+		right.children = append(right.children, node.children[i+1:]...)
+		clear(node.children[i+1:])
+		node.children = node.children[:i+1]
+	}
+	return middle, right
+}
+
+// insert adds item below node, which must be mutable and not full, splitting
+// full children on the way down.
+func (t *BTree[K, V]) insert(node *bTreeNode[K, V], item bTreeItem[K, V]) bool {
+	i, found := t.find(node, item.key)
+	if found {
+		node.items[i] = item
+		return false
+	}
+	if len(node.children) == 0 {
+		node.items = slices.Insert(node.items, i, item)
+		return true
+	}
+	child := t.mutableChild(node, i)
+	if len(child.items) >= t.maxItems() {
+		middle, right := t.split(child, t.degree-1)
+		node.items = slices.Insert(node.items, i, middle)
+		node.children = slices.Insert(node.children, i+1, right)
+		switch c := t.compare(item.key, middle.key); {
+		case c == 0:
+			node.items[i] = item
+			return false
+		case c > 0:
+			child = right
+		}
+	}
+	return t.insert(child, item)
+}
+
+type bTreeRemoval int
+
+const (
+	bTreeRemoveKey bTreeRemoval = iota
+	bTreeRemoveMin
+	bTreeRemoveMax
+)
+
+// remove deletes an entry below node, which must be mutable. Before
+// descending it makes sure the child has more than the minimum number of
+// entries, so a single pass is enough.
+func (t *BTree[K, V]) remove(node *bTreeNode[K, V], key K, kind bTreeRemoval) (bTreeItem[K, V], bool) {
+	var i int
+	var found bool
+	switch kind {
+	case bTreeRemoveMin:
+		if len(node.children) == 0 {
+			return t.removeItemAt(node, 0), true
+		}
+	case bTreeRemoveMax:
+		if len(node.children) == 0 {
+			return t.removeItemAt(node, len(node.items)-1), true
+		}
+		i = len(node.items)
+	default:
+		i, found = t.find(node, key)
+		if len(node.children) == 0 {
+			if !found {
+				return bTreeItem[K, V]{}, false
+			}
+			return t.removeItemAt(node, i), true
+		}
+	}
+	if len(node.children[i].items) <= t.minItems() {
+		t.growChild(node, i)
+		return t.remove(node, key, kind)
+	}
+	child := t.mutableChild(node, i)
+	if found {
+		// Replace the entry with its predecessor from the left subtree.
+		out := node.items[i]
+		node.items[i], _ = t.remove(child, key, bTreeRemoveMax)
+		return out, true
+	}
+	return t.remove(child, key, kind)
+}
+
+// growChild gives children[i] an extra entry by borrowing from a sibling
+// or, if both siblings are at the minimum, by merging with one of them.
+func (t *BTree[K, V]) growChild(node *bTreeNode[K, V], i int) {
+	switch {
+	case i > 0 && len(node.children[i-1].items) > t.minItems():
+		child := t.mutableChild(node, i)
+		left := t.mutableChild(node, i-1)
+		child.items = slices.Insert(child.items, 0, node.items[i-1])
+		node.items[i-1] = t.removeItemAt(left, len(left.items)-1)
+		if len(left.children) > 0 {
+			last := len(left.children) - 1
+			child.children = slices.Insert(child.children, 0, left.children[last])
+			left.children[last] = nil
+			left.children = left.children[:last]
+		}
+	case i < len(node.items) && len(node.children[i+1].items) > t.minItems():
+		child := t.mutableChild(node, i)
+		right := t.mutableChild(node, i+1)
+		child.items = append(child.items, node.items[i])
+		node.items[i] = t.removeItemAt(right, 0)
+		if len(right.children) > 0 {
+			child.children = append(child.children, right.children[0])
+			right.children = slices.Delete(right.children, 0, 1)
+		}
+	default:
+		if i >= len(node.items) {
+			i--
+		}
+		child := t.mutableChild(node, i)
+		sibling := node.children[i+1]
+		child.items = append(child.items, t.removeItemAt(node, i))
+		child.items = append(child.items, sibling.items...)
+		child.children = append(child.children, sibling.children...)
+		node.children = slices.Delete(node.children, i+1, i+2)
+	}
+}
+
+func (t *BTree[K, V]) removeItemAt(node *bTreeNode[K, V], i int) bTreeItem[K, V] {
+	item := node.items[i]
+	node.items = slices.Delete(node.items, i, i+1)
+	return item
+}
+
+// shrinkRoot drops a root left without entries by a merge or a delete.
+func (t *BTree[K, V]) shrinkRoot() {
+	if len(t.root.items) > 0 {
+		return
+	}
+	if len(t.root.children) > 0 {
+		t.root = t.root.children[0]
+	} else {
+		t.root = nil
+	}
+}
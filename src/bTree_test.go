@@ -0,0 +1,246 @@
+package godatastructures
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"math/rand"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkBTree verifies node sizes, key order and that every leaf is at the
+// same depth, and returns the number of entries.
+func checkBTree[K, V any](t *testing.T, tree *BTree[K, V]) int {
+	t.Helper()
+	leafDepth := -1
+	var walk func(node *bTreeNode[K, V], depth int, isRoot bool) int
+	walk = func(node *bTreeNode[K, V], depth int, isRoot bool) int {
+		require.LessOrEqual(t, len(node.items), tree.maxItems())
+		if !isRoot {
+			require.GreaterOrEqual(t, len(node.items), tree.minItems())
+		}
+		for i := 1; i < len(node.items); i++ {
+			require.Negative(t, tree.compare(node.items[i-1].key, node.items[i].key))
+		}
+		if len(node.children) == 0 {
+			if leafDepth == -1 {
+				leafDepth = depth
+			}
+			require.Equal(t, leafDepth, depth)
+			return len(node.items)
+		}
+		require.Len(t, node.children, len(node.items)+1)
+		count := len(node.items)
+		for _, child := range node.children {
+			count += walk(child, depth+1, false)
+		}
+		return count
+	}
+	if tree.root == nil {
+		return 0
+	}
+	count := walk(tree.root, 0, true)
+	require.Equal(t, tree.Size(), count)
+	return count
+}
+
+// identityPairs yields each key mapped to itself, in the given order.
+func identityPairs(keys []int) iter.Seq2[int, int] {
+	return func(yield func(int, int) bool) {
+		for _, k := range keys {
+			if !yield(k, k) {
+				return
+			}
+		}
+	}
+}
+
+func TestBTree(t *testing.T) {
+	t.Run("Empty tree", func(t *testing.T) {
+		tree := NewBTree[int, string](2, cmp.Compare[int])
+		assert.True(t, tree.IsEmpty())
+		_, ok := tree.Get(1)
+		assert.False(t, ok)
+		_, ok = tree.Delete(1)
+		assert.False(t, ok)
+		_, _, ok = tree.Min()
+		assert.False(t, ok)
+		_, _, ok = tree.Max()
+		assert.False(t, ok)
+		assert.Empty(t, slices.Collect(tree.Keys()))
+		assert.Panics(t, func() { NewBTree[int, int](1, cmp.Compare[int]) })
+	})
+
+	t.Run("Put, get and delete", func(t *testing.T) {
+		tree := NewBTree[string, int](2, cmp.Compare[string])
+		assert.True(t, tree.Put("b", 2))
+		assert.True(t, tree.Put("a", 1))
+		assert.False(t, tree.Put("b", 20))
+		val, ok := tree.Get("b")
+		assert.True(t, ok)
+		assert.Equal(t, 20, val)
+
+		val, ok = tree.Delete("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+		assert.Equal(t, 1, tree.Size())
+		assert.False(t, tree.Contains("a"))
+	})
+
+	t.Run("Matches a map under random operations", func(t *testing.T) {
+		for _, degree := range []int{2, 3, 8} {
+			rng := rand.New(rand.NewSource(int64(degree)))
+			tree := NewBTree[int, int](degree, cmp.Compare[int])
+			reference := map[int]int{}
+			for i := range 5000 {
+				key := rng.Intn(1000)
+				if rng.Intn(3) == 0 {
+					want, wantOK := reference[key]
+					delete(reference, key)
+					got, gotOK := tree.Delete(key)
+					require.Equal(t, wantOK, gotOK)
+					require.Equal(t, want, got)
+				} else {
+					_, exists := reference[key]
+					reference[key] = i
+					require.Equal(t, !exists, tree.Put(key, i))
+				}
+			}
+			checkBTree(t, tree)
+			assert.Equal(t, slices.Sorted(maps.Keys(reference)), slices.Collect(tree.Keys()))
+
+			minKey, _, _ := tree.Min()
+			maxKey, _, _ := tree.Max()
+			assert.Equal(t, slices.Min(slices.Collect(maps.Keys(reference))), minKey)
+			assert.Equal(t, slices.Max(slices.Collect(maps.Keys(reference))), maxKey)
+
+			for key := range reference {
+				tree.Delete(key)
+			}
+			assert.True(t, tree.IsEmpty())
+			assert.Nil(t, tree.root)
+		}
+	})
+
+	t.Run("Range scans", func(t *testing.T) {
+		tree := NewBTree[int, int](2, cmp.Compare[int])
+		for i := range 100 {
+			tree.Put(i*2, i)
+		}
+		var keys []int
+		for k := range tree.Range(11, 21) {
+			keys = append(keys, k)
+		}
+		assert.Equal(t, []int{12, 14, 16, 18, 20}, keys)
+
+		keys = nil
+		for k := range tree.Range(0, 1000) {
+			if k == 6 {
+				break
+			}
+			keys = append(keys, k)
+		}
+		assert.Equal(t, []int{0, 2, 4}, keys)
+
+		var backward []int
+		for k := range tree.Backward() {
+			backward = append(backward, k)
+			if len(backward) == 3 {
+				break
+			}
+		}
+		assert.Equal(t, []int{198, 196, 194}, backward)
+	})
+
+	t.Run("Delete a range", func(t *testing.T) {
+		tree := NewBTree[int, int](3, cmp.Compare[int])
+		for i := range 200 {
+			tree.Put(i, i)
+		}
+		assert.Equal(t, 100, tree.DeleteRange(50, 150))
+		assert.Equal(t, 100, tree.Size())
+		assert.False(t, tree.Contains(50))
+		assert.True(t, tree.Contains(49))
+		assert.True(t, tree.Contains(150))
+		assert.Equal(t, 0, tree.DeleteRange(50, 150))
+		checkBTree(t, tree)
+	})
+
+	t.Run("Bulk load from sorted input", func(t *testing.T) {
+		for _, degree := range []int{2, 3, 5} {
+			for _, n := range []int{0, 1, 3, 4, 7, 8, 50, 63, 64, 1000} {
+				keys := make([]int, n)
+				for i := range keys {
+					keys[i] = i * 3
+				}
+				tree, err := BTreeFromSorted(identityPairs(keys), degree, cmp.Compare[int])
+				require.Nil(t, err)
+				require.Equal(t, n, checkBTree(t, tree))
+				assert.Equal(t, keys, slices.AppendSeq([]int{}, tree.Keys()))
+
+				tree.Put(1, -1)
+				tree.Delete(0)
+				checkBTree(t, tree)
+			}
+		}
+
+		_, err := BTreeFromSorted(identityPairs([]int{1, 3, 2}), 2, cmp.Compare[int])
+		assert.ErrorIs(t, err, ErrUnsorted)
+		_, err = BTreeFromSorted(identityPairs([]int{1, 1}), 2, cmp.Compare[int])
+		assert.ErrorIs(t, err, ErrUnsorted)
+	})
+
+	t.Run("Clone is copy-on-write", func(t *testing.T) {
+		tree := NewBTree[int, int](2, cmp.Compare[int])
+		for i := range 100 {
+			tree.Put(i, i)
+		}
+		snapshot := tree.Clone()
+		for i := range 50 {
+			tree.Delete(i)
+			tree.Put(i+1000, i)
+		}
+		snapshot.Put(-1, -1)
+		snapshot.Put(10, 100)
+
+		checkBTree(t, tree)
+		checkBTree(t, snapshot)
+		assert.Equal(t, 100, tree.Size())
+		assert.Equal(t, 101, snapshot.Size())
+		assert.False(t, tree.Contains(-1))
+		assert.False(t, snapshot.Contains(1000))
+		val, _ := snapshot.Get(10)
+		assert.Equal(t, 100, val)
+		assert.False(t, tree.Contains(10))
+	})
+
+	t.Run("Snapshots can be read while the tree is written", func(t *testing.T) {
+		tree := NewBTree[int, int](4, cmp.Compare[int])
+		for i := range 1000 {
+			tree.Put(i, i)
+		}
+		var wg sync.WaitGroup
+		for range 4 {
+			snapshot := tree.Clone()
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				sum := 0
+				for _, v := range snapshot.All() {
+					sum += v
+				}
+				assert.Equal(t, 999*1000/2, sum)
+			}()
+		}
+		for i := range 1000 {
+			tree.Put(i, 0)
+			tree.Delete(i / 2)
+		}
+		wg.Wait()
+	})
+}
@@ -0,0 +1,618 @@
+package godatastructures
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+)
+
+// Binary format shared by every container:
+//
+//	magic   "GDSC"
+//	version 1 byte
+//	type    1 byte, one of the container tags below
+//	count   uvarint
+//	count elements, each written by the element Codec
+//
+// Elements are written in the same logical order as the JSON encoding.
+
+const binaryFormatVersion = 1
+
+var binaryMagic = [4]byte{'G', 'D', 'S', 'C'}
+
+const (
+	tagDynamicArray byte = iota + 1
+	tagStack
+	tagQueue
+	tagHeap
+	tagDeque
+)
+
+// maxPreallocation bounds how many elements are allocated up front from an
+// untrusted element count.
+const maxPreallocation = 1 << 16
+
+// ElementReader is the input a Codec decodes elements from.
+type ElementReader interface {
+	io.Reader
+	io.ByteReader
+}
+
+// Codec encodes and decodes single container elements for the binary
+// format. DefaultCodec covers most types; supply a custom Codec for faster
+// or more compact encoding of non-primitive element types.
+type Codec[T any] interface {
+	Encode(w io.Writer, item T) error
+	Decode(r ElementReader) (T, error)
+}
+
+// DefaultCodec returns the codec used by MarshalBinary, GobEncode, WriteTo
+// and their decoding counterparts. It writes strings and byte slices with a
+// length prefix, int and uint as varints, other fixed-size types with
+// encoding/binary, and uses encoding.BinaryMarshaler when *T implements it.
+// Any other type falls back to GobCodec.
+func DefaultCodec[T any]() Codec[T] {
+	var zero T
+	var codec any
+	switch any(zero).(type) {
+	case string:
+		codec = stringCodec{}
+	case []byte:
+		codec = bytesCodec{}
+	case int:
+		codec = intCodec{}
+	case uint:
+		codec = uintCodec{}
+	default:
+		_, marshaler := any(&zero).(encoding.BinaryMarshaler)
+		_, unmarshaler := any(&zero).(encoding.BinaryUnmarshaler)
+		switch {
+		case marshaler && unmarshaler:
+			codec = binaryMarshalerCodec[T]{}
+		case binary.Size(zero) > 0:
+			codec = fixedSizeCodec[T]{}
+		default:
+			codec = GobCodec[T]()
+		}
+	}
+	return codec.(Codec[T])
+}
+
+// GobCodec returns a codec that encodes each element as a self-contained,
+// length-prefixed gob message. It works for any gob-encodable type but
+// repeats type information for every element.
+func GobCodec[T any]() Codec[T] {
+	return gobCodec[T]{}
+}
+
+type stringCodec struct{}
+
+func (stringCodec) Encode(w io.Writer, item string) error {
+	return writeBytes(w, []byte(item))
+}
+
+func (stringCodec) Decode(r ElementReader) (string, error) {
+	b, err := readBytes(r)
+	return string(b), err
+}
+
+type bytesCodec struct{}
+
+func (bytesCodec) Encode(w io.Writer, item []byte) error {
+	return writeBytes(w, item)
+}
+
+func (bytesCodec) Decode(r ElementReader) ([]byte, error) {
+	return readBytes(r)
+}
+
+type intCodec struct{}
+
+func (intCodec) Encode(w io.Writer, item int) error {
+	// Zig-zag encoding, as in binary.PutVarint
+	v := uint64(item) << 1
+	if item < 0 {
+		v = ^v
+	}
+	return writeUvarint(w, v)
+}
+
+func (intCodec) Decode(r ElementReader) (int, error) {
+	v, err := binary.ReadVarint(r)
+	if err == nil && (v > math.MaxInt || v < math.MinInt) {
+		err = ErrInvalidFormat
+	}
+	return int(v), err
+}
+
+type uintCodec struct{}
+
+func (uintCodec) Encode(w io.Writer, item uint) error {
+	return writeUvarint(w, uint64(item))
+}
+
+func (uintCodec) Decode(r ElementReader) (uint, error) {
+	v, err := binary.ReadUvarint(r)
+	if err == nil && v > math.MaxUint {
+		err = ErrInvalidFormat
+	}
+	return uint(v), err
+}
+
+type fixedSizeCodec[T any] struct{}
+
+func (fixedSizeCodec[T]) Encode(w io.Writer, item T) error {
+	return binary.Write(w, binary.LittleEndian, item)
+}
+
+func (fixedSizeCodec[T]) Decode(r ElementReader) (T, error) {
+	var item T
+	err := binary.Read(r, binary.LittleEndian, &item)
+	return item, err
+}
+
+type binaryMarshalerCodec[T any] struct{}
+
+func (binaryMarshalerCodec[T]) Encode(w io.Writer, item T) error {
+	b, err := any(&item).(encoding.BinaryMarshaler).MarshalBinary()
+	if err != nil {
+		return err
+	}
+	return writeBytes(w, b)
+}
+
+func (binaryMarshalerCodec[T]) Decode(r ElementReader) (T, error) {
+	var item T
+	b, err := readBytes(r)
+	if err != nil {
+		return item, err
+	}
+	err = any(&item).(encoding.BinaryUnmarshaler).UnmarshalBinary(b)
+	return item, err
+}
+
+type gobCodec[T any] struct{}
+
+func (gobCodec[T]) Encode(w io.Writer, item T) error {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(&item); err != nil {
+		return err
+	}
+	return writeBytes(w, buf.Bytes())
+}
+
+func (gobCodec[T]) Decode(r ElementReader) (T, error) {
+	var item T
+	b, err := readBytes(r)
+	if err != nil {
+		return item, err
+	}
+	err = gob.NewDecoder(bytes.NewReader(b)).Decode(&item)
+	return item, err
+}
+
+// writeUvarint writes v in binary.PutUvarint format, avoiding an allocation
+// when w is an io.ByteWriter such as the bufio.Writer codecs are given.
+func writeUvarint(w io.Writer, v uint64) error {
+	bw, ok := w.(io.ByteWriter)
+	if !ok {
+		_, err := w.Write(binary.AppendUvarint(nil, v))
+		return err
+	}
+	for v >= 0x80 {
+		if err := bw.WriteByte(byte(v) | 0x80); err != nil {
+			return err
+		}
+		v >>= 7
+	}
+	return bw.WriteByte(byte(v))
+}
+
+func writeBytes(w io.Writer, b []byte) error {
+	if err := writeUvarint(w, uint64(len(b))); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func readBytes(r ElementReader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	// Grow the buffer as data arrives instead of trusting n up front.
+	var buf bytes.Buffer
+	copied, err := io.CopyN(&buf, r, int64(n))
+	if err == io.EOF || (err == nil && uint64(copied) != n) {
+		err = io.ErrUnexpectedEOF
+	}
+	return buf.Bytes(), err
+}
+
+// writeContainer writes the header and count elements from items to w and
+// returns the number of bytes written.
+func writeContainer[T any](w io.Writer, tag byte, count int, items func(yield func(T) bool), codec Codec[T]) (int64, error) {
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	header := make([]byte, 0, len(binaryMagic)+2+binary.MaxVarintLen64)
+	header = append(header, binaryMagic[:]...)
+	header = append(header, binaryFormatVersion, tag)
+	header = binary.AppendUvarint(header, uint64(count))
+	if _, err := bw.Write(header); err != nil {
+		return cw.n, err
+	}
+	for item := range items {
+		if err := codec.Encode(bw, item); err != nil {
+			return cw.n, err
+		}
+	}
+	err := bw.Flush()
+	return cw.n, err
+}
+
+// readContainer reads a container written by writeContainer with the given
+// tag, passing the element count to reserve (if not nil) and each element to
+// add, and returns the number of bytes read. Unless r implements
+// io.ByteReader it is buffered, so it may be read past the end of the
+// container.
+func readContainer[T any](r io.Reader, tag byte, codec Codec[T], reserve func(count int), add func(T)) (int64, error) {
+	cr := &countingReader{r: r}
+	var er ElementReader
+	if br, ok := r.(ElementReader); ok {
+		cr.br = br
+		er = cr
+	} else {
+		er = bufio.NewReader(cr)
+	}
+
+	var header [6]byte
+	if _, err := io.ReadFull(er, header[:]); err != nil {
+		return cr.n, unexpectedEOF(err)
+	}
+	if [4]byte(header[:4]) != binaryMagic {
+		return cr.n, fmt.Errorf("%w: bad magic %q", ErrInvalidFormat, header[:4])
+	}
+	if header[4] != binaryFormatVersion {
+		return cr.n, fmt.Errorf("%w: unsupported format version %d", ErrInvalidFormat, header[4])
+	}
+	if header[5] != tag {
+		return cr.n, fmt.Errorf("%w: container type %d, expected %d", ErrInvalidFormat, header[5], tag)
+	}
+	count, err := binary.ReadUvarint(er)
+	if err != nil {
+		return cr.n, unexpectedEOF(err)
+	}
+	if reserve != nil {
+		reserve(int(min(count, maxPreallocation)))
+	}
+	for i := uint64(0); i < count; i++ {
+		item, err := codec.Decode(er)
+		if err != nil {
+			return cr.n, unexpectedEOF(err)
+		}
+		add(item)
+	}
+	return cr.n, nil
+}
+
+// readItems reads a whole container into a slice.
+func readItems[T any](r io.Reader, tag byte, codec Codec[T]) ([]T, int64, error) {
+	var items []T
+	n, err := readContainer(r, tag, codec, func(count int) {
+		items = make([]T, 0, count)
+	}, func(item T) {
+		items = append(items, item)
+	})
+	return items, n, err
+}
+
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
+
+type countingReader struct {
+	r  io.Reader
+	br io.ByteReader
+	n  int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.r.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
+
+func (cr *countingReader) ReadByte() (byte, error) {
+	b, err := cr.br.ReadByte()
+	if err == nil {
+		cr.n++
+	}
+	return b, err
+}
+
+func marshalBinary(write func(w io.Writer) (int64, error)) ([]byte, error) {
+	var buf bytes.Buffer
+	_, err := write(&buf)
+	return buf.Bytes(), err
+}
+
+func unmarshalBinary(data []byte, read func(r io.Reader) (int64, error)) error {
+	r := bytes.NewReader(data)
+	if _, err := read(r); err != nil {
+		return err
+	}
+	if r.Len() > 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidFormat, r.Len())
+	}
+	return nil
+}
+
+// WriteTo writes the array in the binary format using DefaultCodec. It
+// implements io.WriterTo.
+func (da *DynamicArray[T]) WriteTo(w io.Writer) (int64, error) {
+	return da.WriteToCodec(w, DefaultCodec[T]())
+}
+
+func (da *DynamicArray[T]) WriteToCodec(w io.Writer, codec Codec[T]) (int64, error) {
+	return writeContainer(w, tagDynamicArray, da.Size(), da.Values(), codec)
+}
+
+// ReadFrom replaces the contents of the array with one read from r using
+// DefaultCodec. It implements io.ReaderFrom. Unless r implements
+// io.ByteReader, it may read past the end of the encoded array.
+func (da *DynamicArray[T]) ReadFrom(r io.Reader) (int64, error) {
+	return da.ReadFromCodec(r, DefaultCodec[T]())
+}
+
+func (da *DynamicArray[T]) ReadFromCodec(r io.Reader, codec Codec[T]) (int64, error) {
+	items, n, err := readItems(r, tagDynamicArray, codec)
+	if err == nil {
+		da.data = items
+	}
+	return n, err
+}
+
+func (da *DynamicArray[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(da.WriteTo)
+}
+
+func (da *DynamicArray[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, da.ReadFrom)
+}
+
+func (da *DynamicArray[T]) GobEncode() ([]byte, error) {
+	return da.MarshalBinary()
+}
+
+func (da *DynamicArray[T]) GobDecode(data []byte) error {
+	return da.UnmarshalBinary(data)
+}
+
+// WriteTo writes the stack from bottom to top in the binary format using
+// DefaultCodec. It implements io.WriterTo.
+func (s *Stack[T]) WriteTo(w io.Writer) (int64, error) {
+	return s.WriteToCodec(w, DefaultCodec[T]())
+}
+
+func (s *Stack[T]) WriteToCodec(w io.Writer, codec Codec[T]) (int64, error) {
+	return writeContainer(w, tagStack, s.Size(), s.Values(), codec)
+}
+
+// ReadFrom replaces the contents of the stack with one read from r using
+// DefaultCodec. It implements io.ReaderFrom. Unless r implements
+// io.ByteReader, it may read past the end of the encoded stack.
+func (s *Stack[T]) ReadFrom(r io.Reader) (int64, error) {
+	return s.ReadFromCodec(r, DefaultCodec[T]())
+}
+
+func (s *Stack[T]) ReadFromCodec(r io.Reader, codec Codec[T]) (int64, error) {
+	items, n, err := readItems(r, tagStack, codec)
+	if err == nil {
+		s.data = items
+	}
+	return n, err
+}
+
+func (s *Stack[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(s.WriteTo)
+}
+
+func (s *Stack[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, s.ReadFrom)
+}
+
+func (s *Stack[T]) GobEncode() ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+func (s *Stack[T]) GobDecode(data []byte) error {
+	return s.UnmarshalBinary(data)
+}
+
+// WriteTo writes the queue from front to rear in the binary format using
+// DefaultCodec. It implements io.WriterTo.
+func (q *Queue[T]) WriteTo(w io.Writer) (int64, error) {
+	return q.WriteToCodec(w, DefaultCodec[T]())
+}
+
+func (q *Queue[T]) WriteToCodec(w io.Writer, codec Codec[T]) (int64, error) {
+	return writeContainer(w, tagQueue, q.Size(), q.Values(), codec)
+}
+
+// ReadFrom replaces the contents of the queue with one read from r using
+// DefaultCodec. It implements io.ReaderFrom. Unless r implements
+// io.ByteReader, it may read past the end of the encoded queue. Elements are
+// enqueued as they are decoded, so the queue is left partially filled if
+// decoding fails.
+func (q *Queue[T]) ReadFrom(r io.Reader) (int64, error) {
+	return q.ReadFromCodec(r, DefaultCodec[T]())
+}
+
+func (q *Queue[T]) ReadFromCodec(r io.Reader, codec Codec[T]) (int64, error) {
+	q.Clear()
+	return readContainer(r, tagQueue, codec, nil, q.Enqueue)
+}
+
+func (q *Queue[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(q.WriteTo)
+}
+
+func (q *Queue[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, q.ReadFrom)
+}
+
+func (q *Queue[T]) GobEncode() ([]byte, error) {
+	return q.MarshalBinary()
+}
+
+func (q *Queue[T]) GobDecode(data []byte) error {
+	return q.UnmarshalBinary(data)
+}
+
+// WriteTo writes the deque from front to back in the binary format using
+// DefaultCodec. It implements io.WriterTo.
+func (d *Deque[T]) WriteTo(w io.Writer) (int64, error) {
+	return d.WriteToCodec(w, DefaultCodec[T]())
+}
+
+func (d *Deque[T]) WriteToCodec(w io.Writer, codec Codec[T]) (int64, error) {
+	return writeContainer(w, tagDeque, d.Size(), d.Values(), codec)
+}
+
+// ReadFrom replaces the contents of the deque with one read from r using
+// DefaultCodec. It implements io.ReaderFrom. Unless r implements
+// io.ByteReader, it may read past the end of the encoded deque. Elements are
+// pushed as they are decoded, so the deque is left partially filled if
+// decoding fails.
+func (d *Deque[T]) ReadFrom(r io.Reader) (int64, error) {
+	return d.ReadFromCodec(r, DefaultCodec[T]())
+}
+
+func (d *Deque[T]) ReadFromCodec(r io.Reader, codec Codec[T]) (int64, error) {
+	d.Clear()
+	return readContainer(r, tagDeque, codec, nil, d.PushBack)
+}
+
+func (d *Deque[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(d.WriteTo)
+}
+
+func (d *Deque[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, d.ReadFrom)
+}
+
+func (d *Deque[T]) GobEncode() ([]byte, error) {
+	return d.MarshalBinary()
+}
+
+func (d *Deque[T]) GobDecode(data []byte) error {
+	return d.UnmarshalBinary(data)
+}
+
+// WriteTo writes the heap in heap (level) order in the binary format using
+// DefaultCodec. It implements io.WriterTo.
+func (h *Heap[T]) WriteTo(w io.Writer) (int64, error) {
+	return h.WriteToCodec(w, DefaultCodec[T]())
+}
+
+func (h *Heap[T]) WriteToCodec(w io.Writer, codec Codec[T]) (int64, error) {
+	if h.data == nil {
+		return writeContainer(w, tagHeap, 0, slices.Values([]T(nil)), codec)
+	}
+	return writeContainer(w, tagHeap, h.Size(), h.Values(), codec)
+}
+
+// ReadFrom replaces the contents of the heap with one read from r using
+// DefaultCodec and re-heapifies it. It implements io.ReaderFrom. The heap
+// must already have a comparator. Unless r implements io.ByteReader, it may
+// read past the end of the encoded heap.
+func (h *Heap[T]) ReadFrom(r io.Reader) (int64, error) {
+	return h.ReadFromCodec(r, DefaultCodec[T]())
+}
+
+func (h *Heap[T]) ReadFromCodec(r io.Reader, codec Codec[T]) (int64, error) {
+	if h.compare == nil {
+		return 0, errors.New("godatastructures: can't decode into a Heap without a comparator")
+	}
+	items, n, err := readItems(r, tagHeap, codec)
+	if err == nil {
+		h.data = &DynamicArray[T]{data: items}
+		h.heapify()
+	}
+	return n, err
+}
+
+func (h *Heap[T]) MarshalBinary() ([]byte, error) {
+	return marshalBinary(h.WriteTo)
+}
+
+func (h *Heap[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, h.ReadFrom)
+}
+
+func (h *Heap[T]) GobEncode() ([]byte, error) {
+	return h.MarshalBinary()
+}
+
+func (h *Heap[T]) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
+
+func (h *MinHeap[T]) ReadFrom(r io.Reader) (int64, error) {
+	return h.ReadFromCodec(r, DefaultCodec[T]())
+}
+
+// ReadFromCodec is like Heap.ReadFromCodec but also works on a zero MinHeap.
+func (h *MinHeap[T]) ReadFromCodec(r io.Reader, codec Codec[T]) (int64, error) {
+	h.ensureComparator()
+	return h.Heap.ReadFromCodec(r, codec)
+}
+
+func (h *MinHeap[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, h.ReadFrom)
+}
+
+func (h *MinHeap[T]) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
+
+func (h *MaxHeap[T]) ReadFrom(r io.Reader) (int64, error) {
+	return h.ReadFromCodec(r, DefaultCodec[T]())
+}
+
+// ReadFromCodec is like Heap.ReadFromCodec but also works on a zero MaxHeap.
+func (h *MaxHeap[T]) ReadFromCodec(r io.Reader, codec Codec[T]) (int64, error) {
+	h.ensureComparator()
+	return h.Heap.ReadFromCodec(r, codec)
+}
+
+func (h *MaxHeap[T]) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary(data, h.ReadFrom)
+}
+
+func (h *MaxHeap[T]) GobDecode(data []byte) error {
+	return h.UnmarshalBinary(data)
+}
@@ -0,0 +1,227 @@
+package godatastructures
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type point struct {
+	X, Y int32
+}
+
+type label struct {
+	Name string
+	Tags []string
+}
+
+// upperCodec is a custom codec storing strings with a one-byte length.
+type upperCodec struct{}
+
+func (upperCodec) Encode(w io.Writer, item string) error {
+	_, err := w.Write(append([]byte{byte(len(item))}, item...))
+	return err
+}
+
+func (upperCodec) Decode(r ElementReader) (string, error) {
+	n, err := r.ReadByte()
+	if err != nil {
+		return "", err
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(r, b)
+	return string(b), err
+}
+
+func TestBinary_RoundTrip(t *testing.T) {
+	t.Run("DynamicArray of ints", func(t *testing.T) {
+		arr := DynamicArrayFromSeq(slices.Values([]int{0, -1, 1 << 40, 7}))
+		data, err := arr.MarshalBinary()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("GDSC"), data[:4])
+
+		var decoded DynamicArray[int]
+		assert.Nil(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, arr.String(), decoded.String())
+	})
+
+	t.Run("Stack of strings", func(t *testing.T) {
+		stack := StackFromSeq(slices.Values([]string{"bottom", "", "top"}))
+		data, err := stack.MarshalBinary()
+		assert.Nil(t, err)
+
+		var decoded Stack[string]
+		assert.Nil(t, decoded.UnmarshalBinary(data))
+		top, _ := decoded.Pop()
+		assert.Equal(t, "top", top)
+		assert.Equal(t, 2, decoded.Size())
+	})
+
+	t.Run("Queue of fixed-size structs", func(t *testing.T) {
+		queue := QueueFromSeq(slices.Values([]point{{1, 2}, {3, 4}}))
+		data, err := queue.MarshalBinary()
+		assert.Nil(t, err)
+
+		decoded := NewRingQueue[point]()
+		assert.Nil(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, []point{{1, 2}, {3, 4}}, slices.Collect(decoded.Values()))
+	})
+
+	t.Run("Deque of floats", func(t *testing.T) {
+		deque := DequeFromSeq(slices.Values([]float64{1.5, -2.25}))
+		deque.PushFront(0)
+		data, err := deque.MarshalBinary()
+		assert.Nil(t, err)
+
+		var decoded Deque[float64]
+		assert.Nil(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, []float64{0, 1.5, -2.25}, slices.Collect(decoded.Values()))
+	})
+
+	t.Run("Heaps re-heapify on decode", func(t *testing.T) {
+		heap := NewMinHeapFrom([]uint{5, 1, 4, 2})
+		data, err := heap.MarshalBinary()
+		assert.Nil(t, err)
+
+		var decoded MinHeap[uint]
+		assert.Nil(t, decoded.UnmarshalBinary(data))
+		assert.Equal(t, []uint{1, 2, 4, 5}, slices.Collect(decoded.Drain()))
+
+		// The same elements decoded into a max heap come out the other way
+		var maxHeap MaxHeap[uint]
+		_, err = maxHeap.ReadFrom(bytes.NewReader(data))
+		assert.Nil(t, err)
+		assert.Equal(t, []uint{5, 4, 2, 1}, slices.Collect(maxHeap.Drain()))
+
+		var noComparator Heap[uint]
+		assert.NotNil(t, noComparator.UnmarshalBinary(data))
+	})
+
+	t.Run("Non-primitive elements fall back to gob", func(t *testing.T) {
+		arr := DynamicArrayFromSeq(slices.Values([]label{{"a", []string{"x"}}, {"b", nil}}))
+		data, err := arr.MarshalBinary()
+		assert.Nil(t, err)
+
+		var decoded DynamicArray[label]
+		assert.Nil(t, decoded.UnmarshalBinary(data))
+		first, _ := decoded.Get(0)
+		assert.Equal(t, label{"a", []string{"x"}}, first)
+	})
+
+	t.Run("Nested containers use their own MarshalBinary", func(t *testing.T) {
+		inner := DynamicArrayFromSeq(slices.Values([]int{1, 2}))
+		outer := NewDynamicArray[DynamicArray[int]](0)
+		outer.Append(*inner)
+
+		data, err := outer.MarshalBinary()
+		assert.Nil(t, err)
+
+		var decoded DynamicArray[DynamicArray[int]]
+		assert.Nil(t, decoded.UnmarshalBinary(data))
+		first, _ := decoded.Get(0)
+		assert.Equal(t, "[1 2]", first.String())
+	})
+}
+
+func TestBinary_Streaming(t *testing.T) {
+	var buf bytes.Buffer
+	arr := DynamicArrayFromSeq(slices.Values([]string{"a", "bb"}))
+	queue := QueueFromSeq(slices.Values([]string{"c"}))
+
+	n1, err := arr.WriteTo(&buf)
+	assert.Nil(t, err)
+	n2, err := queue.WriteToCodec(&buf, upperCodec{})
+	assert.Nil(t, err)
+	assert.Equal(t, int64(buf.Len()), n1+n2)
+
+	// A buffered reader lets several containers be read back from one stream
+	r := bufio.NewReader(&buf)
+	var decodedArr DynamicArray[string]
+	read, err := decodedArr.ReadFrom(r)
+	assert.Nil(t, err)
+	assert.Equal(t, n1, read)
+
+	var decodedQueue Queue[string]
+	read, err = decodedQueue.ReadFromCodec(r, upperCodec{})
+	assert.Nil(t, err)
+	assert.Equal(t, n2, read)
+
+	assert.Equal(t, "[a bb]", decodedArr.String())
+	front, _ := decodedQueue.Peek()
+	assert.Equal(t, "c", front)
+}
+
+func TestBinary_Gob(t *testing.T) {
+	type snapshot struct {
+		Pending *Queue[int]
+		Ranking *MinHeap[int]
+	}
+
+	var buf bytes.Buffer
+	original := snapshot{Pending: QueueFromSeq(slices.Values([]int{1, 2, 3})), Ranking: NewMinHeapFrom([]int{9, 3, 5})}
+	assert.Nil(t, gob.NewEncoder(&buf).Encode(original))
+
+	var decoded snapshot
+	assert.Nil(t, gob.NewDecoder(&buf).Decode(&decoded))
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(decoded.Pending.Values()))
+	assert.Equal(t, []int{3, 5, 9}, slices.Collect(decoded.Ranking.Drain()))
+}
+
+func TestBinary_InvalidInput(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]string{"hello", "world"}))
+	data, err := arr.MarshalBinary()
+	assert.Nil(t, err)
+
+	var decoded DynamicArray[string]
+
+	bad := slices.Clone(data)
+	bad[0] = 'X'
+	assert.ErrorIs(t, decoded.UnmarshalBinary(bad), ErrInvalidFormat)
+
+	bad = slices.Clone(data)
+	bad[4] = binaryFormatVersion + 1
+	assert.ErrorIs(t, decoded.UnmarshalBinary(bad), ErrInvalidFormat)
+
+	var stack Stack[string]
+	assert.ErrorIs(t, stack.UnmarshalBinary(data), ErrInvalidFormat)
+
+	for i := 0; i < len(data); i++ {
+		err := decoded.UnmarshalBinary(data[:i])
+		assert.True(t, errors.Is(err, io.ErrUnexpectedEOF), "truncated at %d: %v", i, err)
+	}
+
+	assert.ErrorIs(t, decoded.UnmarshalBinary(append(slices.Clone(data), 0)), ErrInvalidFormat)
+
+	// A huge element count must not be trusted for allocation
+	header := append([]byte("GDSC"), binaryFormatVersion, tagDynamicArray)
+	header = binary.AppendUvarint(header, 1<<60)
+	var empty DynamicArray[string]
+	assert.ErrorIs(t, empty.UnmarshalBinary(header), io.ErrUnexpectedEOF)
+	assert.Equal(t, "[]", empty.String())
+}
+
+func BenchmarkBinary(b *testing.B) {
+	queue := NewRingQueue[int]()
+	for i := 0; i < 100000; i++ {
+		queue.Enqueue(i)
+	}
+
+	b.Run("WriteTo", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			queue.WriteTo(io.Discard)
+		}
+	})
+
+	b.Run("JSON", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			queue.MarshalJSON()
+		}
+	})
+}
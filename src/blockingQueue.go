@@ -0,0 +1,175 @@
+package godatastructures
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// BlockingQueue is a fixed-capacity FIFO queue that is safe for concurrent
+// use. Put blocks while the queue is full and Take blocks while it is empty.
+//
+// After Close, Put fails with ErrClosed and Take keeps returning the
+// remaining elements, then fails with ErrClosed once the queue is empty.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	queue    *Queue[T]
+	capacity int
+	closed   bool
+	// notEmpty and notFull are created by waiters and closed to wake them.
+	notEmpty chan struct{}
+	notFull  chan struct{}
+}
+
+func NewBlockingQueue[T any](capacity int) *BlockingQueue[T] {
+	if capacity <= 0 {
+		panic("godatastructures: BlockingQueue capacity must be positive")
+	}
+	return &BlockingQueue[T]{queue: NewRingQueue[T](), capacity: capacity}
+}
+
+// Put adds item to the rear of the queue, waiting for space if it is full.
+// It returns ctx.Err() if ctx is done first, or ErrClosed.
+func (bq *BlockingQueue[T]) Put(ctx context.Context, item T) error {
+	for {
+		bq.mu.Lock()
+		if bq.closed {
+			bq.mu.Unlock()
+			return ErrClosed
+		}
+		if bq.queue.Size() < bq.capacity {
+			bq.queue.Enqueue(item)
+			broadcast(&bq.notEmpty)
+			bq.mu.Unlock()
+			return nil
+		}
+		wait := waitChannel(&bq.notFull)
+		bq.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// Take removes and returns the front element, waiting for one if the queue is
+// empty. It returns ctx.Err() if ctx is done first, or ErrClosed.
+func (bq *BlockingQueue[T]) Take(ctx context.Context) (T, error) {
+	for {
+		bq.mu.Lock()
+		if !bq.queue.IsEmpty() {
+			item, _ := bq.queue.Dequeue()
+			broadcast(&bq.notFull)
+			bq.mu.Unlock()
+			return item, nil
+		}
+		if bq.closed {
+			bq.mu.Unlock()
+			var zero T
+			return zero, ErrClosed
+		}
+		wait := waitChannel(&bq.notEmpty)
+		bq.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		case <-wait:
+		}
+	}
+}
+
+// Offer is like Put but gives up after timeout with a *CapacityError. A zero
+// timeout never waits.
+func (bq *BlockingQueue[T]) Offer(item T, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	err := bq.Put(ctx, item)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return &CapacityError{Container: "blocking queue", Op: "offer", Capacity: bq.capacity}
+	}
+	return err
+}
+
+// Poll is like Take but gives up after timeout with an *EmptyError. A zero
+// timeout never waits.
+func (bq *BlockingQueue[T]) Poll(timeout time.Duration) (T, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	item, err := bq.Take(ctx)
+	if errors.Is(err, context.DeadlineExceeded) {
+		return item, emptyError("blocking queue", "poll")
+	}
+	return item, err
+}
+
+// DrainTo moves up to limit elements (all of them if limit <= 0) to the back of
+// dst without blocking and returns how many were moved.
+func (bq *BlockingQueue[T]) DrainTo(dst *DynamicArray[T], limit int) int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	n := 0
+	for !bq.queue.IsEmpty() && (limit <= 0 || n < limit) {
+		item, _ := bq.queue.Dequeue()
+		dst.Append(item)
+		n++
+	}
+	if n > 0 {
+		broadcast(&bq.notFull)
+	}
+	return n
+}
+
+// Close marks the queue as closed and wakes every blocked Put and Take.
+// Closing an already closed queue has no effect.
+func (bq *BlockingQueue[T]) Close() {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+
+	bq.closed = true
+	broadcast(&bq.notEmpty)
+	broadcast(&bq.notFull)
+}
+
+func (bq *BlockingQueue[T]) IsClosed() bool {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.closed
+}
+
+func (bq *BlockingQueue[T]) Size() int {
+	bq.mu.Lock()
+	defer bq.mu.Unlock()
+	return bq.queue.Size()
+}
+
+func (bq *BlockingQueue[T]) IsEmpty() bool {
+	return bq.Size() == 0
+}
+
+func (bq *BlockingQueue[T]) Capacity() int {
+	return bq.capacity
+}
+
+// waitChannel returns the channel a waiter should block on, creating it if
+// needed. The caller must hold the lock guarding *ch.
+func waitChannel(ch *chan struct{}) chan struct{} {
+	if *ch == nil {
+		*ch = make(chan struct{})
+	}
+	return *ch
+}
+
+// broadcast wakes every waiter blocked on *ch. The caller must hold the lock
+// guarding *ch.
+func broadcast(ch *chan struct{}) {
+	if *ch != nil {
+		close(*ch)
+		*ch = nil
+	}
+}
@@ -0,0 +1,176 @@
+package godatastructures
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	t.Run("Put and Take in FIFO order", func(t *testing.T) {
+		bq := NewBlockingQueue[int](3)
+		ctx := context.Background()
+		for i := 1; i <= 3; i++ {
+			assert.Nil(t, bq.Put(ctx, i))
+		}
+		assert.Equal(t, 3, bq.Size())
+		assert.Equal(t, 3, bq.Capacity())
+
+		for i := 1; i <= 3; i++ {
+			val, err := bq.Take(ctx)
+			assert.Nil(t, err)
+			assert.Equal(t, i, val)
+		}
+		assert.True(t, bq.IsEmpty())
+	})
+
+	t.Run("Put blocks until space is available", func(t *testing.T) {
+		bq := NewBlockingQueue[int](1)
+		ctx := context.Background()
+		assert.Nil(t, bq.Put(ctx, 1))
+
+		done := make(chan error)
+		go func() {
+			done <- bq.Put(ctx, 2)
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("Put should block while the queue is full")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		val, err := bq.Take(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, val)
+		assert.Nil(t, <-done)
+
+		val, err = bq.Take(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, val)
+	})
+
+	t.Run("Context cancellation", func(t *testing.T) {
+		bq := NewBlockingQueue[int](1)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := bq.Take(ctx)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+		assert.Nil(t, bq.Put(context.Background(), 1))
+		err = bq.Put(ctx, 2)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("Offer and Poll with timeouts", func(t *testing.T) {
+		bq := NewBlockingQueue[string](1)
+
+		_, err := bq.Poll(0)
+		assert.ErrorIs(t, err, ErrEmpty)
+
+		assert.Nil(t, bq.Offer("a", 0))
+		err = bq.Offer("b", 5*time.Millisecond)
+		assert.ErrorIs(t, err, ErrCapacityExceeded)
+
+		val, err := bq.Poll(time.Second)
+		assert.Nil(t, err)
+		assert.Equal(t, "a", val)
+	})
+
+	t.Run("Close wakes all waiters", func(t *testing.T) {
+		bq := NewBlockingQueue[int](1)
+		ctx := context.Background()
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 3)
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := bq.Take(ctx)
+				errs <- err
+			}()
+		}
+		time.Sleep(10 * time.Millisecond)
+		bq.Close()
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			assert.ErrorIs(t, err, ErrClosed)
+		}
+		assert.True(t, bq.IsClosed())
+		assert.ErrorIs(t, bq.Put(ctx, 1), ErrClosed)
+		bq.Close()
+	})
+
+	t.Run("Take returns remaining elements after Close", func(t *testing.T) {
+		bq := NewBlockingQueue[int](2)
+		ctx := context.Background()
+		bq.Put(ctx, 1)
+		bq.Close()
+
+		val, err := bq.Take(ctx)
+		assert.Nil(t, err)
+		assert.Equal(t, 1, val)
+
+		_, err = bq.Take(ctx)
+		assert.ErrorIs(t, err, ErrClosed)
+	})
+
+	t.Run("DrainTo", func(t *testing.T) {
+		bq := NewBlockingQueue[int](5)
+		ctx := context.Background()
+		for i := 0; i < 5; i++ {
+			bq.Put(ctx, i)
+		}
+
+		dst := NewDynamicArray[int](0)
+		assert.Equal(t, 2, bq.DrainTo(dst, 2))
+		assert.Equal(t, "[0 1]", dst.String())
+
+		assert.Equal(t, 3, bq.DrainTo(dst, 0))
+		assert.Equal(t, "[0 1 2 3 4]", dst.String())
+		assert.Equal(t, 0, bq.DrainTo(dst, 0))
+	})
+
+	t.Run("Concurrent producers and consumers", func(t *testing.T) {
+		bq := NewBlockingQueue[int](4)
+		ctx := context.Background()
+		const producers, perProducer = 4, 250
+
+		var wg sync.WaitGroup
+		for p := 0; p < producers; p++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < perProducer; i++ {
+					bq.Put(ctx, 1)
+				}
+			}()
+		}
+
+		sums := make(chan int)
+		for c := 0; c < 2; c++ {
+			go func() {
+				sum := 0
+				for {
+					val, err := bq.Take(ctx)
+					if err != nil {
+						sums <- sum
+						return
+					}
+					sum += val
+				}
+			}()
+		}
+
+		wg.Wait()
+		bq.Close()
+		assert.Equal(t, producers*perProducer, <-sums+<-sums)
+	})
+}
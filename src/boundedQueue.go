@@ -0,0 +1,108 @@
+package godatastructures
+
+import "iter"
+
+// BoundedQueue is a FIFO queue holding at most Capacity elements. When it is
+// full, Enqueue follows its OverflowPolicy and counts every element it drops
+// or rejects so callers can alert on them.
+type BoundedQueue[T any] struct {
+	ring     boundedStorage[T]
+	capacity int
+	policy   OverflowPolicy
+	dropped  uint64
+	rejected uint64
+}
+
+func NewBoundedQueue[T any](capacity int, policy OverflowPolicy) *BoundedQueue[T] {
+	if capacity <= 0 {
+		panic("godatastructures: BoundedQueue capacity must be positive")
+	}
+	return &BoundedQueue[T]{ring: newBoundedStorage[T](capacity, policy), capacity: capacity, policy: policy}
+}
+
+// Enqueue adds item to the rear of the queue. It only fails when the queue
+// is full and the policy is OverflowReject.
+func (bq *BoundedQueue[T]) Enqueue(item T) error {
+	if bq.ring.Size() < bq.capacity {
+		bq.ring.PushBack(item)
+		return nil
+	}
+	switch bq.policy {
+	case OverflowDropOldest:
+		bq.ring.PopFront()
+		bq.ring.PushBack(item)
+		bq.dropped++
+	case OverflowOverwrite:
+		bq.ring.(*fixedRing[T]).overwrite(item)
+		bq.dropped++
+	case OverflowDropNewest:
+		bq.dropped++
+	default:
+		bq.rejected++
+		return &CapacityError{Container: "queue", Op: "enqueue", Capacity: bq.capacity}
+	}
+	return nil
+}
+
+func (bq *BoundedQueue[T]) Dequeue() (T, error) {
+	if bq.ring.IsEmpty() {
+		var zero T
+		return zero, emptyError("queue", "dequeue")
+	}
+	return bq.ring.PopFront()
+}
+
+func (bq *BoundedQueue[T]) Peek() (T, error) {
+	if bq.ring.IsEmpty() {
+		var zero T
+		return zero, emptyError("queue", "peek")
+	}
+	return bq.ring.Front()
+}
+
+func (bq *BoundedQueue[T]) Rear() (T, error) {
+	if bq.ring.IsEmpty() {
+		var zero T
+		return zero, emptyError("queue", "rear")
+	}
+	return bq.ring.Back()
+}
+
+func (bq *BoundedQueue[T]) Size() int {
+	return bq.ring.Size()
+}
+
+func (bq *BoundedQueue[T]) IsEmpty() bool {
+	return bq.ring.IsEmpty()
+}
+
+func (bq *BoundedQueue[T]) IsFull() bool {
+	return bq.ring.Size() >= bq.capacity
+}
+
+func (bq *BoundedQueue[T]) Capacity() int {
+	return bq.capacity
+}
+
+func (bq *BoundedQueue[T]) Policy() OverflowPolicy {
+	return bq.policy
+}
+
+func (bq *BoundedQueue[T]) Clear() {
+	bq.ring.Clear()
+}
+
+// Dropped returns how many elements were evicted or discarded on overflow.
+func (bq *BoundedQueue[T]) Dropped() uint64 {
+	return bq.dropped
+}
+
+// Rejected returns how many Enqueue calls failed under OverflowReject.
+func (bq *BoundedQueue[T]) Rejected() uint64 {
+	return bq.rejected
+}
+
+// Values yields each element from front to rear.
+func (bq *BoundedQueue[T]) Values() iter.Seq[T] {
+	return bq.ring.Values()
+}
@@ -0,0 +1,91 @@
+package godatastructures
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fillBoundedQueue(policy OverflowPolicy, items ...int) *BoundedQueue[int] {
+	bq := NewBoundedQueue[int](3, policy)
+	for _, item := range items {
+		bq.Enqueue(item)
+	}
+	return bq
+}
+
+func TestBoundedQueue(t *testing.T) {
+	t.Run("Behaves like a queue below capacity", func(t *testing.T) {
+		bq := NewBoundedQueue[int](3, OverflowReject)
+		assert.True(t, bq.IsEmpty())
+		_, err := bq.Dequeue()
+		assert.ErrorIs(t, err, ErrEmpty)
+
+		assert.Nil(t, bq.Enqueue(1))
+		assert.Nil(t, bq.Enqueue(2))
+		front, _ := bq.Peek()
+		rear, _ := bq.Rear()
+		assert.Equal(t, 1, front)
+		assert.Equal(t, 2, rear)
+		assert.False(t, bq.IsFull())
+
+		val, err := bq.Dequeue()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, val)
+		assert.Equal(t, 1, bq.Size())
+	})
+
+	t.Run("Reject returns a capacity error", func(t *testing.T) {
+		bq := fillBoundedQueue(OverflowReject, 1, 2, 3)
+		assert.True(t, bq.IsFull())
+
+		err := bq.Enqueue(4)
+		assert.ErrorIs(t, err, ErrCapacityExceeded)
+		var capErr *CapacityError
+		if assert.True(t, errors.As(err, &capErr)) {
+			assert.Equal(t, 3, capErr.Capacity)
+		}
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(bq.Values()))
+		assert.Equal(t, uint64(1), bq.Rejected())
+		assert.Equal(t, uint64(0), bq.Dropped())
+	})
+
+	t.Run("Drop oldest evicts the front", func(t *testing.T) {
+		bq := fillBoundedQueue(OverflowDropOldest, 1, 2, 3, 4, 5)
+		assert.Equal(t, []int{3, 4, 5}, slices.Collect(bq.Values()))
+		assert.Equal(t, uint64(2), bq.Dropped())
+	})
+
+	t.Run("Drop newest discards the incoming item", func(t *testing.T) {
+		bq := fillBoundedQueue(OverflowDropNewest, 1, 2, 3, 4, 5)
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(bq.Values()))
+		assert.Equal(t, uint64(2), bq.Dropped())
+		assert.Equal(t, uint64(0), bq.Rejected())
+	})
+
+	t.Run("Overwrite keeps the most recent items", func(t *testing.T) {
+		bq := fillBoundedQueue(OverflowOverwrite, 1, 2, 3, 4, 5, 6, 7)
+		assert.Equal(t, []int{5, 6, 7}, slices.Collect(bq.Values()))
+		assert.Equal(t, uint64(4), bq.Dropped())
+
+		val, _ := bq.Dequeue()
+		assert.Equal(t, 5, val)
+		assert.Nil(t, bq.Enqueue(8))
+		assert.Equal(t, uint64(4), bq.Dropped())
+	})
+
+	t.Run("Overwrite does not allocate once full", func(t *testing.T) {
+		bq := fillBoundedQueue(OverflowOverwrite, 1, 2, 3)
+		allocs := testing.AllocsPerRun(100, func() {
+			bq.Enqueue(4)
+		})
+		assert.Zero(t, allocs)
+		assert.Equal(t, []int{4, 4, 4}, slices.Collect(bq.Values()))
+	})
+
+	t.Run("Panics on non-positive capacity", func(t *testing.T) {
+		assert.Panics(t, func() { NewBoundedQueue[int](0, OverflowReject) })
+	})
+}
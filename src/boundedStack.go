@@ -0,0 +1,100 @@
+package godatastructures
+
+import "iter"
+
+// BoundedStack is a LIFO stack holding at most Capacity elements. When it is
+// full, Push follows its OverflowPolicy, where the oldest element is the one
+// at the bottom, and counts every element it drops or rejects.
+type BoundedStack[T any] struct {
+	ring     boundedStorage[T]
+	capacity int
+	policy   OverflowPolicy
+	dropped  uint64
+	rejected uint64
+}
+
+func NewBoundedStack[T any](capacity int, policy OverflowPolicy) *BoundedStack[T] {
+	if capacity <= 0 {
+		panic("godatastructures: BoundedStack capacity must be positive")
+	}
+	return &BoundedStack[T]{ring: newBoundedStorage[T](capacity, policy), capacity: capacity, policy: policy}
+}
+
+// Push adds item on top of the stack. It only fails when the stack is full
+// and the policy is OverflowReject.
+func (bs *BoundedStack[T]) Push(item T) error {
+	if bs.ring.Size() < bs.capacity {
+		bs.ring.PushBack(item)
+		return nil
+	}
+	switch bs.policy {
+	case OverflowDropOldest:
+		bs.ring.PopFront()
+		bs.ring.PushBack(item)
+		bs.dropped++
+	case OverflowOverwrite:
+		bs.ring.(*fixedRing[T]).overwrite(item)
+		bs.dropped++
+	case OverflowDropNewest:
+		bs.dropped++
+	default:
+		bs.rejected++
+		return &CapacityError{Container: "stack", Op: "push", Capacity: bs.capacity}
+	}
+	return nil
+}
+
+func (bs *BoundedStack[T]) Pop() (T, error) {
+	if bs.ring.IsEmpty() {
+		var zero T
+		return zero, emptyError("stack", "pop")
+	}
+	return bs.ring.PopBack()
+}
+
+func (bs *BoundedStack[T]) Peek() (T, error) {
+	if bs.ring.IsEmpty() {
+		var zero T
+		return zero, emptyError("stack", "peek")
+	}
+	return bs.ring.Back()
+}
+
+func (bs *BoundedStack[T]) Size() int {
+	return bs.ring.Size()
+}
+
+func (bs *BoundedStack[T]) IsEmpty() bool {
+	return bs.ring.IsEmpty()
+}
+
+func (bs *BoundedStack[T]) IsFull() bool {
+	return bs.ring.Size() >= bs.capacity
+}
+
+func (bs *BoundedStack[T]) Capacity() int {
+	return bs.capacity
+}
+
+func (bs *BoundedStack[T]) Policy() OverflowPolicy {
+	return bs.policy
+}
+
+func (bs *BoundedStack[T]) Clear() {
+	bs.ring.Clear()
+}
+
+// Dropped returns how many elements were evicted or discarded on overflow.
+func (bs *BoundedStack[T]) Dropped() uint64 {
+	return bs.dropped
+}
+
+// Rejected returns how many Push calls failed under OverflowReject.
+func (bs *BoundedStack[T]) Rejected() uint64 {
+	return bs.rejected
+}
+
+// Values yields each element from bottom to top.
+func (bs *BoundedStack[T]) Values() iter.Seq[T] {
+	return bs.ring.Values()
+}
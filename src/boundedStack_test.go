@@ -0,0 +1,89 @@
+package godatastructures
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func fillBoundedStack(policy OverflowPolicy, items ...int) *BoundedStack[int] {
+	bs := NewBoundedStack[int](3, policy)
+	for _, item := range items {
+		bs.Push(item)
+	}
+	return bs
+}
+
+func TestBoundedStack(t *testing.T) {
+	t.Run("Behaves like a stack below capacity", func(t *testing.T) {
+		bs := NewBoundedStack[int](3, OverflowReject)
+		_, err := bs.Pop()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = bs.Peek()
+		assert.ErrorIs(t, err, ErrEmpty)
+
+		bs.Push(1)
+		bs.Push(2)
+		top, _ := bs.Peek()
+		assert.Equal(t, 2, top)
+		val, err := bs.Pop()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, val)
+		assert.Equal(t, 1, bs.Size())
+	})
+
+	t.Run("Reject returns a capacity error", func(t *testing.T) {
+		bs := fillBoundedStack(OverflowReject, 1, 2, 3)
+		assert.True(t, bs.IsFull())
+		assert.ErrorIs(t, bs.Push(4), ErrCapacityExceeded)
+		top, _ := bs.Peek()
+		assert.Equal(t, 3, top)
+		assert.Equal(t, uint64(1), bs.Rejected())
+	})
+
+	t.Run("Drop oldest evicts the bottom", func(t *testing.T) {
+		bs := fillBoundedStack(OverflowDropOldest, 1, 2, 3, 4)
+		assert.Equal(t, []int{2, 3, 4}, slices.Collect(bs.Values()))
+		assert.Equal(t, uint64(1), bs.Dropped())
+	})
+
+	t.Run("Drop newest discards the incoming item", func(t *testing.T) {
+		bs := fillBoundedStack(OverflowDropNewest, 1, 2, 3, 4)
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(bs.Values()))
+		assert.Equal(t, uint64(1), bs.Dropped())
+	})
+
+	t.Run("Overwrite acts as a ring of recent pushes", func(t *testing.T) {
+		bs := fillBoundedStack(OverflowOverwrite, 1, 2, 3, 4, 5)
+		for _, want := range []int{5, 4, 3} {
+			val, err := bs.Pop()
+			assert.Nil(t, err)
+			assert.Equal(t, want, val)
+		}
+		assert.True(t, bs.IsEmpty())
+		assert.Equal(t, uint64(2), bs.Dropped())
+	})
+
+	t.Run("Overwrite wraps around after pops", func(t *testing.T) {
+		bs := fillBoundedStack(OverflowOverwrite, 1, 2, 3, 4)
+		bs.Pop()
+		bs.Push(5)
+		bs.Push(6)
+		assert.Equal(t, []int{3, 5, 6}, slices.Collect(bs.Values()))
+		assert.Equal(t, uint64(2), bs.Dropped())
+	})
+
+	t.Run("Clear keeps capacity and counters", func(t *testing.T) {
+		for _, policy := range []OverflowPolicy{OverflowDropOldest, OverflowOverwrite} {
+			bs := fillBoundedStack(policy, 1, 2, 3, 4)
+			bs.Clear()
+			assert.True(t, bs.IsEmpty())
+			assert.Equal(t, uint64(1), bs.Dropped())
+			bs.Push(5)
+			top, _ := bs.Peek()
+			assert.Equal(t, 5, top)
+			assert.Equal(t, 3, bs.Capacity())
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package godatastructures
+
+// Cache is the interface shared by LRUCache, LFUCache and ARCCache so the
+// eviction policy can be chosen at runtime.
+type Cache[K comparable, V any] interface {
+	// Get returns the value for key and records the access.
+	Get(key K) (V, bool)
+	// Peek returns the value for key without recording an access.
+	Peek(key K) (V, bool)
+	Put(key K, value V) error
+	Remove(key K) (V, bool)
+	Contains(key K) bool
+	Len() int
+	Stats() CacheStats
+}
+
+// CacheStats counts cache lookups through Get and entries evicted to make
+// room or because they expired.
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+}
+
+// HitRate returns the fraction of Get calls that were hits, or 0 if there
+// were none.
+func (s CacheStats) HitRate() float64 {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(total)
+}
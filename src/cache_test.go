@@ -0,0 +1,43 @@
+package godatastructures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCache(t *testing.T) {
+	policies := map[string]func(capacity int) Cache[string, int]{
+		"LRU": func(capacity int) Cache[string, int] { return NewLRUCache[string, int](capacity) },
+		"LFU": func(capacity int) Cache[string, int] { return NewLFUCache[string, int](capacity) },
+		"ARC": func(capacity int) Cache[string, int] { return NewARCCache[string, int](capacity) },
+	}
+	for name, newCache := range policies {
+		t.Run(name, func(t *testing.T) {
+			c := newCache(2)
+			assert.Nil(t, c.Put("a", 1))
+			assert.Nil(t, c.Put("b", 2))
+			assert.Nil(t, c.Put("c", 3))
+			assert.Equal(t, 2, c.Len())
+			assert.True(t, c.Contains("c"))
+
+			val, ok := c.Get("c")
+			assert.True(t, ok)
+			assert.Equal(t, 3, val)
+			_, ok = c.Get("missing")
+			assert.False(t, ok)
+			c.Peek("c")
+
+			stats := c.Stats()
+			assert.Equal(t, CacheStats{Hits: 1, Misses: 1, Evictions: 1}, stats)
+			assert.Equal(t, 0.5, stats.HitRate())
+
+			val, ok = c.Remove("c")
+			assert.True(t, ok)
+			assert.Equal(t, 3, val)
+			assert.Equal(t, 1, c.Len())
+		})
+	}
+
+	assert.Equal(t, 0.0, CacheStats{}.HitRate())
+}
@@ -0,0 +1,93 @@
+package godatastructures
+
+import "sync/atomic"
+
+type concurrentNode[T any] struct {
+	value T
+	next  atomic.Pointer[concurrentNode[T]]
+}
+
+// ConcurrentQueue is an unbounded lock-free FIFO queue (Michael-Scott) that
+// is safe for use by multiple producers and consumers. It must be created
+// with NewConcurrentQueue.
+type ConcurrentQueue[T any] struct {
+	// head points at a dummy node; the front element is head.next.
+	head atomic.Pointer[concurrentNode[T]]
+	tail atomic.Pointer[concurrentNode[T]]
+	size atomic.Int64
+}
+
+func NewConcurrentQueue[T any]() *ConcurrentQueue[T] {
+	q := &ConcurrentQueue[T]{}
+	dummy := &concurrentNode[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+func (q *ConcurrentQueue[T]) Enqueue(item T) {
+	node := &concurrentNode[T]{value: item}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// The tail is lagging behind; help move it forward.
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, node) {
+			q.tail.CompareAndSwap(tail, node)
+			q.size.Add(1)
+			return
+		}
+	}
+}
+
+func (q *ConcurrentQueue[T]) Dequeue() (T, error) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zero T
+			return zero, emptyError("queue", "dequeue")
+		}
+		if head == tail {
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		// next becomes the new dummy node and keeps its value reachable
+		// until the following Dequeue; it cannot be cleared here because
+		// other consumers may still be reading it.
+		value := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			return value, nil
+		}
+	}
+}
+
+func (q *ConcurrentQueue[T]) Peek() (T, error) {
+	next := q.head.Load().next.Load()
+	if next == nil {
+		var zero T
+		return zero, emptyError("queue", "peek")
+	}
+	return next.value, nil
+}
+
+// Size returns the number of elements. Under concurrent modification it is
+// only a snapshot and may briefly lag behind completed operations.
+func (q *ConcurrentQueue[T]) Size() int {
+	return int(max(q.size.Load(), 0))
+}
+
+func (q *ConcurrentQueue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
@@ -0,0 +1,129 @@
+package godatastructures
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentQueue(t *testing.T) {
+	t.Run("Sequential FIFO order", func(t *testing.T) {
+		q := NewConcurrentQueue[int]()
+		assert.True(t, q.IsEmpty())
+
+		_, err := q.Dequeue()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = q.Peek()
+		assert.ErrorIs(t, err, ErrEmpty)
+
+		for i := 1; i <= 3; i++ {
+			q.Enqueue(i)
+		}
+		assert.Equal(t, 3, q.Size())
+
+		front, err := q.Peek()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, front)
+
+		for i := 1; i <= 3; i++ {
+			val, err := q.Dequeue()
+			assert.Nil(t, err)
+			assert.Equal(t, i, val)
+		}
+		assert.True(t, q.IsEmpty())
+		assert.Equal(t, 0, q.Size())
+	})
+
+	t.Run("Concurrent producers and consumers", func(t *testing.T) {
+		q := NewConcurrentQueue[int]()
+		const producers, perProducer = 8, 2000
+
+		var wg sync.WaitGroup
+		for p := 0; p < producers; p++ {
+			wg.Add(1)
+			go func(p int) {
+				defer wg.Done()
+				for i := 0; i < perProducer; i++ {
+					q.Enqueue(p*perProducer + i)
+				}
+			}(p)
+		}
+
+		results := make(chan []int, producers)
+		var consumed sync.WaitGroup
+		var left atomic.Int64
+		left.Store(producers * perProducer)
+		for c := 0; c < producers; c++ {
+			consumed.Add(1)
+			go func() {
+				defer consumed.Done()
+				var got []int
+				for left.Load() > 0 {
+					val, err := q.Dequeue()
+					if err != nil {
+						continue
+					}
+					left.Add(-1)
+					got = append(got, val)
+				}
+				results <- got
+			}()
+		}
+
+		wg.Wait()
+		consumed.Wait()
+		close(results)
+
+		seen := make([]bool, producers*perProducer)
+		for got := range results {
+			// Each producer's values must come out in the order they went in
+			last := make(map[int]int)
+			for _, v := range got {
+				p := v / perProducer
+				if prev, ok := last[p]; ok {
+					assert.Less(t, prev, v)
+				}
+				last[p] = v
+				assert.False(t, seen[v], "value %d dequeued twice", v)
+				seen[v] = true
+			}
+		}
+		for v, ok := range seen {
+			assert.True(t, ok, "value %d was lost", v)
+		}
+		assert.True(t, q.IsEmpty())
+	})
+}
+
+func BenchmarkConcurrentQueue(b *testing.B) {
+	b.Run("ConcurrentQueue", func(b *testing.B) {
+		q := NewConcurrentQueue[int]()
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				if i%2 == 0 {
+					q.Enqueue(i)
+				} else {
+					q.Dequeue()
+				}
+			}
+		})
+	})
+
+	b.Run("MutexQueue", func(b *testing.B) {
+		q := NewQueue[int]()
+		var mu sync.Mutex
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				mu.Lock()
+				if i%2 == 0 {
+					q.Enqueue(i)
+				} else {
+					q.Dequeue()
+				}
+				mu.Unlock()
+			}
+		})
+	})
+}
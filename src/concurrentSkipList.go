@@ -0,0 +1,173 @@
+package godatastructures
+
+import (
+	"iter"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+)
+
+type concurrentSkipNode[K, V any] struct {
+	key   K
+	value atomic.Pointer[V]
+	next  []atomic.Pointer[concurrentSkipNode[K, V]]
+}
+
+// ConcurrentSkipList is a SkipList that readers can use without locking
+// while writers take turns behind a mutex. A new node is fully built before
+// it is linked in, from the bottom level up, and a removed node keeps its
+// forward pointers, so a reader never sees a partial node and never loses
+// its place. Reads see every entry that was present for their whole
+// duration; iterators are weakly consistent and may or may not see writes
+// made while they run. It must be created with NewConcurrentSkipList or
+// NewSeededConcurrentSkipList.
+type ConcurrentSkipList[K, V any] struct {
+	head    *concurrentSkipNode[K, V]
+	level   atomic.Int32
+	size    atomic.Int64
+	compare func(a, b K) int
+
+	// mu serializes writers and guards rng.
+	mu  sync.Mutex
+	rng *rand.Rand
+}
+
+func NewConcurrentSkipList[K, V any](compare func(a, b K) int) *ConcurrentSkipList[K, V] {
+	return NewSeededConcurrentSkipList[K, V](compare, rand.Uint64())
+}
+
+// NewSeededConcurrentSkipList returns an empty list whose levels are drawn
+// from a generator seeded with seed.
+func NewSeededConcurrentSkipList[K, V any](compare func(a, b K) int, seed uint64) *ConcurrentSkipList[K, V] {
+	sl := &ConcurrentSkipList[K, V]{
+		head:    &concurrentSkipNode[K, V]{next: make([]atomic.Pointer[concurrentSkipNode[K, V]], skipListMaxLevel)},
+		compare: compare,
+		rng:     rand.New(rand.NewPCG(seed, seed)),
+	}
+	sl.level.Store(1)
+	return sl
+}
+
+func (sl *ConcurrentSkipList[K, V]) Size() int {
+	return int(sl.size.Load())
+}
+
+func (sl *ConcurrentSkipList[K, V]) IsEmpty() bool {
+	return sl.size.Load() == 0
+}
+
+func (sl *ConcurrentSkipList[K, V]) Get(key K) (V, bool) {
+	node := sl.seek(key, nil)
+	if node != nil && sl.compare(node.key, key) == 0 {
+		return *node.value.Load(), true
+	}
+	var zero V
+	return zero, false
+}
+
+func (sl *ConcurrentSkipList[K, V]) Contains(key K) bool {
+	_, ok := sl.Get(key)
+	return ok
+}
+
+// Set stores value under key and reports whether the key was new.
+func (sl *ConcurrentSkipList[K, V]) Set(key K, value V) bool {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	var update [skipListMaxLevel]*concurrentSkipNode[K, V]
+	node := sl.seek(key, &update)
+	if node != nil && sl.compare(node.key, key) == 0 {
+		node.value.Store(&value)
+		return false
+	}
+
+	level := randomSkipLevel(sl.rng)
+	current := int(sl.level.Load())
+	for i := current; i < level; i++ {
+		update[i] = sl.head
+	}
+
+	node = &concurrentSkipNode[K, V]{key: key, next: make([]atomic.Pointer[concurrentSkipNode[K, V]], level)}
+	node.value.Store(&value)
+	for i := range level {
+		node.next[i].Store(update[i].next[i].Load())
+	}
+	for i := range level {
+		update[i].next[i].Store(node)
+	}
+	if level > current {
+		sl.level.Store(int32(level))
+	}
+	sl.size.Add(1)
+	return true
+}
+
+// Delete removes key and returns its value, if it was present.
+func (sl *ConcurrentSkipList[K, V]) Delete(key K) (V, bool) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	var update [skipListMaxLevel]*concurrentSkipNode[K, V]
+	node := sl.seek(key, &update)
+	if node == nil || sl.compare(node.key, key) != 0 {
+		var zero V
+		return zero, false
+	}
+	// Unlink from the top down so the node stays reachable on the lower
+	// levels until it is gone from the ones above.
+	for i := len(node.next) - 1; i >= 0; i-- {
+		update[i].next[i].Store(node.next[i].Load())
+	}
+	level := sl.level.Load()
+	for level > 1 && sl.head.next[level-1].Load() == nil {
+		level--
+	}
+	sl.level.Store(level)
+	sl.size.Add(-1)
+	return *node.value.Load(), true
+}
+
+// All yields every entry in ascending key order.
+func (sl *ConcurrentSkipList[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		sl.iterate(sl.head.next[0].Load(), yield)
+	}
+}
+
+// Seek yields the entries with keys greater than or equal to key, in
+// ascending order.
+func (sl *ConcurrentSkipList[K, V]) Seek(key K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		sl.iterate(sl.seek(key, nil), yield)
+	}
+}
+
+func (sl *ConcurrentSkipList[K, V]) iterate(node *concurrentSkipNode[K, V], yield func(K, V) bool) {
+	for ; node != nil; node = node.next[0].Load() {
+		if !yield(node.key, *node.value.Load()) {
+			return
+		}
+	}
+}
+
+// seek returns the first node with a key not less than key, or nil. If
+// update is not nil it receives the last node before that point on every
+// level; only writers pass it. The result is the pointer already compared
+// on the bottom level: loading it again could return a node inserted in the
+// meantime.
+func (sl *ConcurrentSkipList[K, V]) seek(key K, update *[skipListMaxLevel]*concurrentSkipNode[K, V]) *concurrentSkipNode[K, V] {
+	node := sl.head
+	var next *concurrentSkipNode[K, V]
+	for i := int(sl.level.Load()) - 1; i >= 0; i-- {
+		next = node.next[i].Load()
+		for next != nil && sl.compare(next.key, key) < 0 {
+			node = next
+			next = node.next[i].Load()
+		}
+		if update != nil {
+			update[i] = node
+		}
+	}
+	return next
+}
@@ -0,0 +1,89 @@
+package godatastructures
+
+import (
+	"cmp"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentSkipList(t *testing.T) {
+	t.Run("Single goroutine", func(t *testing.T) {
+		sl := NewConcurrentSkipList[int, string](cmp.Compare[int])
+		assert.True(t, sl.IsEmpty())
+		assert.True(t, sl.Set(2, "two"))
+		assert.True(t, sl.Set(1, "one"))
+		assert.False(t, sl.Set(2, "TWO"))
+
+		val, ok := sl.Get(2)
+		assert.True(t, ok)
+		assert.Equal(t, "TWO", val)
+
+		var keys []int
+		for k := range sl.Seek(2) {
+			keys = append(keys, k)
+		}
+		assert.Equal(t, []int{2}, keys)
+
+		val, ok = sl.Delete(1)
+		assert.True(t, ok)
+		assert.Equal(t, "one", val)
+		_, ok = sl.Delete(1)
+		assert.False(t, ok)
+		assert.Equal(t, 1, sl.Size())
+	})
+
+	t.Run("Readers run alongside a writer", func(t *testing.T) {
+		sl := NewSeededConcurrentSkipList[int, int](cmp.Compare[int], 3)
+		// Even keys are never touched by the writer, so every reader must
+		// always find them.
+		for i := 0; i < 1000; i += 2 {
+			sl.Set(i, i)
+		}
+
+		var wg sync.WaitGroup
+		done := make(chan struct{})
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for {
+					select {
+					case <-done:
+						return
+					default:
+					}
+					for i := 0; i < 1000; i += 2 {
+						val, ok := sl.Get(i)
+						if !ok || val != i {
+							t.Errorf("Get(%d) = %d, %v", i, val, ok)
+							return
+						}
+					}
+					var keys []int
+					for k := range sl.All() {
+						keys = append(keys, k)
+					}
+					if !slices.IsSorted(keys) {
+						t.Error("iteration out of order")
+						return
+					}
+				}
+			}()
+		}
+
+		for round := range 20 {
+			for i := 1; i < 1000; i += 2 {
+				sl.Set(i, round)
+			}
+			for i := 1; i < 1000; i += 2 {
+				sl.Delete(i)
+			}
+		}
+		close(done)
+		wg.Wait()
+		assert.Equal(t, 500, sl.Size())
+	})
+}
@@ -0,0 +1,63 @@
+package godatastructures
+
+import "sync/atomic"
+
+type stackNode[T any] struct {
+	value T
+	next  *stackNode[T]
+}
+
+// ConcurrentStack is an unbounded lock-free LIFO stack (Treiber) that is safe
+// for concurrent use. The zero value is an empty stack ready to use.
+type ConcurrentStack[T any] struct {
+	top  atomic.Pointer[stackNode[T]]
+	size atomic.Int64
+}
+
+func NewConcurrentStack[T any]() *ConcurrentStack[T] {
+	return &ConcurrentStack[T]{}
+}
+
+func (s *ConcurrentStack[T]) Push(item T) {
+	node := &stackNode[T]{value: item}
+	for {
+		node.next = s.top.Load()
+		if s.top.CompareAndSwap(node.next, node) {
+			s.size.Add(1)
+			return
+		}
+	}
+}
+
+func (s *ConcurrentStack[T]) Pop() (T, error) {
+	for {
+		top := s.top.Load()
+		if top == nil {
+			var zero T
+			return zero, emptyError("stack", "pop")
+		}
+		if s.top.CompareAndSwap(top, top.next) {
+			s.size.Add(-1)
+			return top.value, nil
+		}
+	}
+}
+
+func (s *ConcurrentStack[T]) Peek() (T, error) {
+	top := s.top.Load()
+	if top == nil {
+		var zero T
+		return zero, emptyError("stack", "peek")
+	}
+	return top.value, nil
+}
+
+// Size returns the number of elements. Under concurrent modification it is
+// only a snapshot and may briefly lag behind completed operations.
+func (s *ConcurrentStack[T]) Size() int {
+	return int(max(s.size.Load(), 0))
+}
+
+func (s *ConcurrentStack[T]) IsEmpty() bool {
+	return s.top.Load() == nil
+}
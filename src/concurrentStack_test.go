@@ -0,0 +1,105 @@
+package godatastructures
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrentStack(t *testing.T) {
+	t.Run("Sequential LIFO order", func(t *testing.T) {
+		var s ConcurrentStack[int]
+		assert.True(t, s.IsEmpty())
+
+		_, err := s.Pop()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = s.Peek()
+		assert.ErrorIs(t, err, ErrEmpty)
+
+		for i := 1; i <= 3; i++ {
+			s.Push(i)
+		}
+		assert.Equal(t, 3, s.Size())
+
+		top, err := s.Peek()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, top)
+
+		for i := 3; i >= 1; i-- {
+			val, err := s.Pop()
+			assert.Nil(t, err)
+			assert.Equal(t, i, val)
+		}
+		assert.True(t, s.IsEmpty())
+	})
+
+	t.Run("Concurrent push and pop", func(t *testing.T) {
+		s := NewConcurrentStack[int]()
+		const workers, perWorker = 8, 2000
+
+		var wg sync.WaitGroup
+		popped := make(chan int, workers*perWorker)
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < perWorker; i++ {
+					s.Push(w*perWorker + i)
+					if i%2 == 1 {
+						if val, err := s.Pop(); err == nil {
+							popped <- val
+						}
+					}
+				}
+			}(w)
+		}
+		wg.Wait()
+		for !s.IsEmpty() {
+			val, _ := s.Pop()
+			popped <- val
+		}
+		close(popped)
+
+		seen := make([]bool, workers*perWorker)
+		for v := range popped {
+			assert.False(t, seen[v], "value %d popped twice", v)
+			seen[v] = true
+		}
+		for v, ok := range seen {
+			assert.True(t, ok, "value %d was lost", v)
+		}
+		assert.Equal(t, 0, s.Size())
+	})
+}
+
+func BenchmarkConcurrentStack(b *testing.B) {
+	b.Run("ConcurrentStack", func(b *testing.B) {
+		s := NewConcurrentStack[int]()
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				if i%2 == 0 {
+					s.Push(i)
+				} else {
+					s.Pop()
+				}
+			}
+		})
+	})
+
+	b.Run("MutexStack", func(b *testing.B) {
+		s := NewStack[int]()
+		var mu sync.Mutex
+		b.RunParallel(func(pb *testing.PB) {
+			for i := 0; pb.Next(); i++ {
+				mu.Lock()
+				if i%2 == 0 {
+					s.Push(i)
+				} else {
+					s.Pop()
+				}
+				mu.Unlock()
+			}
+		})
+	})
+}
@@ -0,0 +1,195 @@
+package godatastructures
+
+import "iter"
+
+const minDequeCapacity = 8
+
+// Deque is a double-ended queue backed by a circular buffer whose capacity
+// is always a power of two. It grows by doubling and halves once it is a
+// quarter full, like DynamicArray.
+type Deque[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+func NewDeque[T any]() *Deque[T] {
+	return &Deque[T]{}
+}
+
+// DequeFromSeq returns a new deque with the elements of seq pushed to the back
+// in order.
+func DequeFromSeq[T any](seq iter.Seq[T]) *Deque[T] {
+	d := NewDeque[T]()
+	d.Collect(seq)
+	return d
+}
+
+func (d *Deque[T]) PushBack(item T) {
+	d.grow()
+	d.data[d.index(d.size)] = item
+	d.size++
+}
+
+func (d *Deque[T]) PushFront(item T) {
+	d.grow()
+	d.head = d.index(len(d.data) - 1)
+	d.data[d.head] = item
+	d.size++
+}
+
+func (d *Deque[T]) PopFront() (T, error) {
+	var zero T
+	if d.size <= 0 {
+		return zero, emptyError("deque", "pop front")
+	}
+	item := d.data[d.head]
+	d.data[d.head] = zero
+	d.head = d.index(1)
+	d.size--
+	d.downsize()
+	return item, nil
+}
+
+func (d *Deque[T]) PopBack() (T, error) {
+	var zero T
+	if d.size <= 0 {
+		return zero, emptyError("deque", "pop back")
+	}
+	tail := d.index(d.size - 1)
+	item := d.data[tail]
+	d.data[tail] = zero
+	d.size--
+	d.downsize()
+	return item, nil
+}
+
+func (d *Deque[T]) Front() (T, error) {
+	if d.size <= 0 {
+		var zero T
+		return zero, emptyError("deque", "front")
+	}
+	return d.data[d.head], nil
+}
+
+func (d *Deque[T]) Back() (T, error) {
+	if d.size <= 0 {
+		var zero T
+		return zero, emptyError("deque", "back")
+	}
+	return d.data[d.index(d.size-1)], nil
+}
+
+// At returns the element at position index counted from the front.
+func (d *Deque[T]) At(index int) (T, error) {
+	if index >= d.size || index < 0 {
+		var zero T
+		return zero, indexError("at", index, d.size)
+	}
+	return d.data[d.index(index)], nil
+}
+
+func (d *Deque[T]) Size() int {
+	return d.size
+}
+
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size <= 0
+}
+
+func (d *Deque[T]) Clear() {
+	d.data = nil
+	d.head = 0
+	d.size = 0
+}
+
+// Collect pushes every element of seq to the back of the deque.
+func (d *Deque[T]) Collect(seq iter.Seq[T]) {
+	for item := range seq {
+		d.PushBack(item)
+	}
+}
+
+// All yields each element from front to back together with its position.
+// The size is re-read on every step, so elements pushed to the back during
+// iteration are visited; pushing or popping at the front shifts positions.
+func (d *Deque[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < d.size; i++ {
+			if !yield(i, d.data[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values yields each element from front to back, with the same mutation
+// semantics as All.
+func (d *Deque[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range d.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields each element from back to front together with its
+// position. If the deque shrinks during iteration, it continues from the new
+// back element.
+func (d *Deque[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := d.size - 1; i >= 0; i = min(i, d.size) - 1 {
+			if !yield(i, d.data[d.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain pops and yields elements from the front until the deque is empty or
+// the loop stops. Elements pushed during iteration are drained as well.
+func (d *Deque[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !d.IsEmpty() {
+			item, _ := d.PopFront()
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// index maps a position relative to the front onto the circular buffer.
+func (d *Deque[T]) index(offset int) int {
+	return (d.head + offset) & (len(d.data) - 1)
+}
+
+func (d *Deque[T]) grow() {
+	if d.size < len(d.data) {
+		return
+	}
+	d.resize(max(2*len(d.data), minDequeCapacity))
+}
+
+func (d *Deque[T]) downsize() {
+	capacity := len(d.data)
+	if capacity > minDequeCapacity && d.size <= capacity/4 {
+		d.resize(capacity / 2)
+	}
+}
+
+func (d *Deque[T]) resize(capacity int) {
+	newData := make([]T, capacity)
+	if d.size > 0 {
+		if d.head+d.size <= len(d.data) {
+			copy(newData, d.data[d.head:d.head+d.size])
+		} else {
+			n := copy(newData, d.data[d.head:])
+			copy(newData[n:], d.data[:d.size-n])
+		}
+	}
+	d.data = newData
+	d.head = 0
+}
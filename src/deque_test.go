@@ -0,0 +1,133 @@
+package godatastructures
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeque(t *testing.T) {
+	t.Run("New deque is empty", func(t *testing.T) {
+		d := NewDeque[int]()
+		assert.True(t, d.IsEmpty())
+		assert.Equal(t, 0, d.Size())
+
+		_, err := d.Front()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = d.Back()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = d.PopFront()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = d.PopBack()
+		assert.ErrorIs(t, err, ErrEmpty)
+	})
+
+	t.Run("Push and pop at both ends", func(t *testing.T) {
+		d := NewDeque[int]()
+		d.PushBack(2)
+		d.PushBack(3)
+		d.PushFront(1)
+		d.PushFront(0)
+		assert.Equal(t, []int{0, 1, 2, 3}, slices.Collect(d.Values()))
+
+		front, err := d.Front()
+		assert.Nil(t, err)
+		assert.Equal(t, 0, front)
+		back, err := d.Back()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, back)
+
+		val, err := d.PopFront()
+		assert.Nil(t, err)
+		assert.Equal(t, 0, val)
+		val, err = d.PopBack()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, val)
+		assert.Equal(t, 2, d.Size())
+	})
+
+	t.Run("At", func(t *testing.T) {
+		d := DequeFromSeq(slices.Values([]string{"a", "b", "c"}))
+		d.PushFront("z")
+
+		val, err := d.At(0)
+		assert.Nil(t, err)
+		assert.Equal(t, "z", val)
+		val, err = d.At(3)
+		assert.Nil(t, err)
+		assert.Equal(t, "c", val)
+
+		_, err = d.At(4)
+		assert.ErrorIs(t, err, ErrIndexOutOfRange)
+		_, err = d.At(-1)
+		assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	})
+
+	t.Run("Wraps around the buffer", func(t *testing.T) {
+		d := NewDeque[int]()
+		for i := 0; i < minDequeCapacity; i++ {
+			d.PushBack(i)
+		}
+		for i := 0; i < 5; i++ {
+			d.PopFront()
+		}
+		for i := minDequeCapacity; i < minDequeCapacity+5; i++ {
+			d.PushBack(i)
+		}
+		assert.Equal(t, minDequeCapacity, len(d.data))
+
+		// Growing while wrapped must preserve order
+		d.PushBack(100)
+		assert.Equal(t, []int{5, 6, 7, 8, 9, 10, 11, 12, 100}, slices.Collect(d.Values()))
+	})
+
+	t.Run("Capacity stays a power of two and shrinks on drain", func(t *testing.T) {
+		d := NewDeque[int]()
+		for i := 0; i < 1000; i++ {
+			d.PushBack(i)
+		}
+		grown := len(d.data)
+		assert.Equal(t, 0, grown&(grown-1))
+
+		for i := 0; i < 990; i++ {
+			val, err := d.PopFront()
+			assert.Nil(t, err)
+			assert.Equal(t, i, val)
+		}
+		assert.Less(t, len(d.data), grown)
+		assert.Equal(t, 0, len(d.data)&(len(d.data)-1))
+		assert.Equal(t, []int{990, 991, 992, 993, 994, 995, 996, 997, 998, 999}, slices.Collect(d.Values()))
+	})
+
+	t.Run("Iterators", func(t *testing.T) {
+		d := DequeFromSeq(slices.Values([]int{1, 2, 3}))
+
+		var backward []int
+		for i, v := range d.Backward() {
+			assert.Equal(t, v-1, i)
+			backward = append(backward, v)
+		}
+		assert.Equal(t, []int{3, 2, 1}, backward)
+
+		var drained []int
+		for v := range d.Drain() {
+			drained = append(drained, v)
+			if v == 1 {
+				d.PushBack(4)
+			}
+		}
+		assert.Equal(t, []int{1, 2, 3, 4}, drained)
+		assert.True(t, d.IsEmpty())
+	})
+
+	t.Run("Clear", func(t *testing.T) {
+		d := DequeFromSeq(slices.Values([]int{1, 2, 3}))
+		d.Clear()
+		assert.True(t, d.IsEmpty())
+		d.PushFront(7)
+		val, err := d.Back()
+		assert.Nil(t, err)
+		assert.Equal(t, 7, val)
+	})
+}
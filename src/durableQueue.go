@@ -0,0 +1,603 @@
+package godatastructures
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// SyncPolicy controls when a DurableQueue flushes its log to stable storage.
+type SyncPolicy int
+
+const (
+	// SyncAlways fsyncs after every record: nothing acknowledged by
+	// Enqueue or Ack is lost, even on power failure.
+	SyncAlways SyncPolicy = iota
+	// SyncBatch fsyncs after every SyncBatchSize records, on segment
+	// rollover and on Sync/Close.
+	SyncBatch
+	// SyncNone leaves flushing to the operating system. Records survive a
+	// process crash but not a machine crash.
+	SyncNone
+)
+
+const (
+	defaultSegmentSize   = 64 << 20
+	defaultSyncBatchSize = 64
+	segmentSuffix        = ".seg"
+	recordHeaderSize     = 8
+	maxRecordSize        = 1 << 30
+)
+
+const (
+	recordEnqueue byte = iota + 1
+	recordAck
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// DurableQueueOptions configures OpenDurableQueue. The zero value uses
+// SyncAlways, 64 MiB segments and DefaultCodec.
+type DurableQueueOptions[T any] struct {
+	Sync          SyncPolicy
+	SyncBatchSize int
+	SegmentSize   int64
+	Codec         Codec[T]
+}
+
+// Lease is an element handed out by DurableQueue.Dequeue. It stays in the
+// log until it is acknowledged with Ack; Nack returns it to the front of the
+// queue, and leases that are never settled are delivered again after the
+// queue is reopened.
+type Lease[T any] struct {
+	ID    uint64
+	Value T
+}
+
+type durableEntry[T any] struct {
+	id    uint64
+	value T
+}
+
+// DurableQueue is a FIFO queue persisted to append-only segment files in a
+// directory, so queued and in-flight elements survive restarts. Every record
+// is checksummed; on open the log is replayed up to the last valid record
+// and anything after it is discarded. It is safe for concurrent use.
+type DurableQueue[T any] struct {
+	mu      sync.Mutex
+	dir     string
+	opts    DurableQueueOptions[T]
+	pending *Deque[durableEntry[T]]
+	leased  map[uint64]durableEntry[T]
+	nextID  uint64
+
+	active      *os.File
+	activeIndex uint64
+	activeSize  int64
+	unsynced    int
+	closed      bool
+	// syncFile flushes a segment; tests replace it to inject failures.
+	syncFile func(f *os.File) error
+}
+
+// OpenDurableQueue opens the queue stored in dir, creating the directory if
+// needed, and recovers its contents from the log.
+func OpenDurableQueue[T any](dir string, opts DurableQueueOptions[T]) (*DurableQueue[T], error) {
+	if opts.SegmentSize <= 0 {
+		opts.SegmentSize = defaultSegmentSize
+	}
+	if opts.SyncBatchSize <= 0 {
+		opts.SyncBatchSize = defaultSyncBatchSize
+	}
+	if opts.Codec == nil {
+		opts.Codec = DefaultCodec[T]()
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	dq := &DurableQueue[T]{
+		dir:     dir,
+		opts:    opts,
+		pending: NewDeque[durableEntry[T]](),
+		leased:  make(map[uint64]durableEntry[T]),
+		nextID:  1,
+
+		syncFile: (*os.File).Sync,
+	}
+	if err := dq.recover(); err != nil {
+		return nil, err
+	}
+	return dq, nil
+}
+
+// Enqueue appends item to the rear of the queue and writes it to the log.
+// If the record was written but flushing it failed, the element is still
+// queued, since it will be replayed on reopen, and the error is returned.
+func (dq *DurableQueue[T]) Enqueue(item T) error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.closed {
+		return ErrClosed
+	}
+
+	var payload bytes.Buffer
+	if err := dq.opts.Codec.Encode(&payload, item); err != nil {
+		return err
+	}
+	id := dq.nextID
+	written, err := dq.appendRecord(recordEnqueue, id, payload.Bytes())
+	if written {
+		dq.nextID++
+		dq.pending.PushBack(durableEntry[T]{id: id, value: item})
+	}
+	return err
+}
+
+// Dequeue removes the front element and returns it as a lease. The element
+// is only deleted from the log once the lease is acknowledged.
+func (dq *DurableQueue[T]) Dequeue() (*Lease[T], error) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.closed {
+		return nil, ErrClosed
+	}
+	if dq.pending.IsEmpty() {
+		return nil, emptyError("durable queue", "dequeue")
+	}
+	entry, _ := dq.pending.PopFront()
+	dq.leased[entry.id] = entry
+	return &Lease[T]{ID: entry.id, Value: entry.value}, nil
+}
+
+func (dq *DurableQueue[T]) Peek() (T, error) {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	var zero T
+	if dq.closed {
+		return zero, ErrClosed
+	}
+	if dq.pending.IsEmpty() {
+		return zero, emptyError("durable queue", "peek")
+	}
+	entry, _ := dq.pending.Front()
+	return entry.value, nil
+}
+
+// Ack permanently removes a leased element. Like Enqueue, it settles the
+// lease once the record is written, even if flushing it fails.
+func (dq *DurableQueue[T]) Ack(lease *Lease[T]) error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.closed {
+		return ErrClosed
+	}
+	if lease == nil {
+		return ErrInvalidHandle
+	}
+	if _, ok := dq.leased[lease.ID]; !ok {
+		return ErrInvalidHandle
+	}
+	written, err := dq.appendRecord(recordAck, lease.ID, nil)
+	if written {
+		delete(dq.leased, lease.ID)
+	}
+	return err
+}
+
+// Nack returns a leased element to the front of the queue.
+func (dq *DurableQueue[T]) Nack(lease *Lease[T]) error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.closed {
+		return ErrClosed
+	}
+	if lease == nil {
+		return ErrInvalidHandle
+	}
+	entry, ok := dq.leased[lease.ID]
+	if !ok {
+		return ErrInvalidHandle
+	}
+	delete(dq.leased, lease.ID)
+	dq.pending.PushFront(entry)
+	return nil
+}
+
+// Size returns the number of elements waiting to be dequeued, not counting
+// leased ones.
+func (dq *DurableQueue[T]) Size() int {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return dq.pending.Size()
+}
+
+func (dq *DurableQueue[T]) IsEmpty() bool {
+	return dq.Size() == 0
+}
+
+// InFlight returns the number of leased elements not yet acknowledged.
+func (dq *DurableQueue[T]) InFlight() int {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	return len(dq.leased)
+}
+
+// Sync flushes the active segment to stable storage.
+func (dq *DurableQueue[T]) Sync() error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.closed {
+		return ErrClosed
+	}
+	return dq.sync()
+}
+
+// Compact rewrites the log so it only holds the elements that are still
+// queued or leased, then deletes the old segments. Leased elements keep
+// their lease.
+func (dq *DurableQueue[T]) Compact() error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.closed {
+		return ErrClosed
+	}
+
+	live := slices.Collect(dq.pending.Values())
+	for _, entry := range dq.leased {
+		live = append(live, entry)
+	}
+	slices.SortFunc(live, func(a, b durableEntry[T]) int {
+		return cmp.Compare(a.id, b.id)
+	})
+
+	// Write the live entries to a temporary file and rename it into place
+	// as the next segment, so a crash leaves either the old log or both.
+	index := dq.activeIndex + 1
+	tmpPath := dq.segmentPath(index) + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(tmp)
+	var size int64
+	for _, entry := range live {
+		var payload bytes.Buffer
+		if err := dq.opts.Codec.Encode(&payload, entry.value); err != nil {
+			tmp.Close()
+			return err
+		}
+		record := encodeRecord(recordEnqueue, entry.id, payload.Bytes())
+		if _, err := w.Write(record); err != nil {
+			tmp.Close()
+			return err
+		}
+		size += int64(len(record))
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, dq.segmentPath(index)); err != nil {
+		return err
+	}
+	if err := syncDir(dq.dir); err != nil {
+		return err
+	}
+
+	// Keep appending to the current segment until the old ones are gone:
+	// if anything below fails, the queue carries on with the old log, and
+	// recovery skips the compacted copies of entries it already has.
+	next, err := dq.openSegment(index)
+	if err != nil {
+		return err
+	}
+	oldIndexes, err := dq.segmentIndexes()
+	if err != nil {
+		next.Close()
+		return err
+	}
+	for _, old := range oldIndexes {
+		if old < index {
+			if err := os.Remove(dq.segmentPath(old)); err != nil {
+				next.Close()
+				return err
+			}
+		}
+	}
+	// The old segment is already deleted, so there is nothing left to
+	// flush to it.
+	dq.active.Close()
+	dq.setActive(next, index, size)
+	return nil
+}
+
+// Close syncs and closes the log. Unacknowledged leases are delivered again
+// when the queue is reopened.
+func (dq *DurableQueue[T]) Close() error {
+	dq.mu.Lock()
+	defer dq.mu.Unlock()
+	if dq.closed {
+		return nil
+	}
+	dq.closed = true
+	err := dq.sync()
+	if closeErr := dq.active.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// appendRecord writes a record to the active segment and reports whether it
+// made it into the file. Once it has, the record will be replayed on reopen,
+// so callers must apply it even if the returned error, from flushing or
+// rolling over, is not nil.
+func (dq *DurableQueue[T]) appendRecord(kind byte, id uint64, payload []byte) (bool, error) {
+	record := encodeRecord(kind, id, payload)
+	if _, err := dq.active.Write(record); err != nil {
+		// Cut off a partly written record, which would otherwise end
+		// replay there and hide every record appended after it.
+		if truncErr := dq.active.Truncate(dq.activeSize); truncErr != nil {
+			return false, errors.Join(err, truncErr)
+		}
+		return false, err
+	}
+	dq.activeSize += int64(len(record))
+	dq.unsynced++
+
+	switch {
+	case dq.opts.Sync == SyncAlways,
+		dq.opts.Sync == SyncBatch && dq.unsynced >= dq.opts.SyncBatchSize:
+		if err := dq.sync(); err != nil {
+			return true, err
+		}
+	}
+	if dq.activeSize >= dq.opts.SegmentSize {
+		return true, dq.rollover()
+	}
+	return true, nil
+}
+
+func (dq *DurableQueue[T]) sync() error {
+	if dq.unsynced == 0 || dq.opts.Sync == SyncNone {
+		return nil
+	}
+	if err := dq.syncFile(dq.active); err != nil {
+		return err
+	}
+	dq.unsynced = 0
+	return nil
+}
+
+// rollover moves on to a new segment. If it fails, the current segment stays
+// active and the next append tries again.
+func (dq *DurableQueue[T]) rollover() error {
+	if err := dq.sync(); err != nil {
+		return err
+	}
+	next, err := dq.openSegment(dq.activeIndex + 1)
+	if err != nil {
+		return err
+	}
+	// The old segment is already flushed, so a failure to close it loses
+	// nothing and the queue carries on with the new one.
+	err = dq.active.Close()
+	dq.setActive(next, dq.activeIndex+1, 0)
+	return err
+}
+
+func (dq *DurableQueue[T]) openActive(index uint64, size int64) error {
+	file, err := dq.openSegment(index)
+	if err != nil {
+		return err
+	}
+	dq.setActive(file, index, size)
+	return nil
+}
+
+func (dq *DurableQueue[T]) openSegment(index uint64) (*os.File, error) {
+	return os.OpenFile(dq.segmentPath(index), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+}
+
+func (dq *DurableQueue[T]) setActive(file *os.File, index uint64, size int64) {
+	dq.active = file
+	dq.activeIndex = index
+	dq.activeSize = size
+	dq.unsynced = 0
+}
+
+// recover replays every segment in order. At the first torn or corrupt
+// record the segment is truncated there and any later segments are removed.
+func (dq *DurableQueue[T]) recover() error {
+	if err := dq.removeTempFiles(); err != nil {
+		return err
+	}
+	indexes, err := dq.segmentIndexes()
+	if err != nil {
+		return err
+	}
+
+	live := make(map[uint64]T)
+	var order []uint64
+	seen := make(map[uint64]bool)
+	apply := func(kind byte, id uint64, payload []byte) error {
+		switch kind {
+		case recordEnqueue:
+			// A compaction that did not get to delete the old segments
+			// leaves duplicate enqueue records behind, possibly after the
+			// element was acknowledged.
+			if seen[id] {
+				return nil
+			}
+			value, err := dq.opts.Codec.Decode(bytes.NewReader(payload))
+			if err != nil {
+				return err
+			}
+			seen[id] = true
+			live[id] = value
+			order = append(order, id)
+		case recordAck:
+			seen[id] = true
+			delete(live, id)
+		}
+		dq.nextID = max(dq.nextID, id+1)
+		return nil
+	}
+
+	activeIndex, activeSize := uint64(0), int64(0)
+	for i, index := range indexes {
+		valid, err := dq.replaySegment(index, apply)
+		if err != nil {
+			return err
+		}
+		activeIndex, activeSize = index, valid
+		if info, err := os.Stat(dq.segmentPath(index)); err == nil && info.Size() > valid {
+			if err := os.Truncate(dq.segmentPath(index), valid); err != nil {
+				return err
+			}
+			for _, later := range indexes[i+1:] {
+				if err := os.Remove(dq.segmentPath(later)); err != nil {
+					return err
+				}
+			}
+			break
+		}
+	}
+
+	slices.Sort(order)
+	for _, id := range order {
+		if value, ok := live[id]; ok {
+			dq.pending.PushBack(durableEntry[T]{id: id, value: value})
+		}
+	}
+	return dq.openActive(activeIndex, activeSize)
+}
+
+// replaySegment passes every valid record of a segment to apply and returns
+// the offset just past the last valid one.
+func (dq *DurableQueue[T]) replaySegment(index uint64, apply func(kind byte, id uint64, payload []byte) error) (int64, error) {
+	file, err := os.Open(dq.segmentPath(index))
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	r := bufio.NewReader(file)
+	var offset int64
+	for {
+		kind, id, payload, n, ok := readRecord(r)
+		if !ok {
+			return offset, nil
+		}
+		if err := apply(kind, id, payload); err != nil {
+			return offset, fmt.Errorf("replaying %s: %w", dq.segmentPath(index), err)
+		}
+		offset += n
+	}
+}
+
+func (dq *DurableQueue[T]) segmentIndexes() ([]uint64, error) {
+	entries, err := os.ReadDir(dq.dir)
+	if err != nil {
+		return nil, err
+	}
+	var indexes []uint64
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), segmentSuffix)
+		if !ok {
+			continue
+		}
+		if index, err := strconv.ParseUint(name, 10, 64); err == nil {
+			indexes = append(indexes, index)
+		}
+	}
+	slices.Sort(indexes)
+	return indexes, nil
+}
+
+func (dq *DurableQueue[T]) removeTempFiles() error {
+	tmpFiles, err := filepath.Glob(filepath.Join(dq.dir, "*"+segmentSuffix+".tmp"))
+	if err != nil {
+		return err
+	}
+	for _, path := range tmpFiles {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// syncDir flushes dir itself, making renames and new files in it durable.
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	return d.Sync()
+}
+
+func (dq *DurableQueue[T]) segmentPath(index uint64) string {
+	return filepath.Join(dq.dir, fmt.Sprintf("%020d%s", index, segmentSuffix))
+}
+
+// encodeRecord frames a log record as
+//
+//	length uint32 | crc32c uint32 | kind byte | id uvarint | payload
+//
+// where length and the checksum cover everything after the header.
+func encodeRecord(kind byte, id uint64, payload []byte) []byte {
+	body := make([]byte, 0, 1+binary.MaxVarintLen64+len(payload))
+	body = append(body, kind)
+	body = binary.AppendUvarint(body, id)
+	body = append(body, payload...)
+
+	record := make([]byte, recordHeaderSize, recordHeaderSize+len(body))
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(body)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(body, crcTable))
+	return append(record, body...)
+}
+
+// readRecord reads one record and reports false if it is missing, torn or
+// fails its checksum.
+func readRecord(r io.Reader) (kind byte, id uint64, payload []byte, n int64, ok bool) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return 0, 0, nil, 0, false
+	}
+	length := binary.LittleEndian.Uint32(header[0:4])
+	if length < 2 || length > maxRecordSize {
+		return 0, 0, nil, 0, false
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, 0, nil, 0, false
+	}
+	if crc32.Checksum(body, crcTable) != binary.LittleEndian.Uint32(header[4:8]) {
+		return 0, 0, nil, 0, false
+	}
+	id, size := binary.Uvarint(body[1:])
+	if size <= 0 || (body[0] != recordEnqueue && body[0] != recordAck) {
+		return 0, 0, nil, 0, false
+	}
+	return body[0], id, body[1+size:], int64(recordHeaderSize + length), true
+}
@@ -0,0 +1,299 @@
+package godatastructures
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestQueue(t *testing.T, dir string, opts DurableQueueOptions[string]) *DurableQueue[string] {
+	t.Helper()
+	dq, err := OpenDurableQueue(dir, opts)
+	require.NoError(t, err)
+	return dq
+}
+
+func dequeueAll(t *testing.T, dq *DurableQueue[string], ack bool) []string {
+	t.Helper()
+	var values []string
+	for !dq.IsEmpty() {
+		lease, err := dq.Dequeue()
+		require.NoError(t, err)
+		if ack {
+			require.NoError(t, dq.Ack(lease))
+		}
+		values = append(values, lease.Value)
+	}
+	return values
+}
+
+func TestDurableQueue(t *testing.T) {
+	t.Run("FIFO with leases", func(t *testing.T) {
+		dq := openTestQueue(t, t.TempDir(), DurableQueueOptions[string]{})
+		defer dq.Close()
+
+		_, err := dq.Dequeue()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = dq.Peek()
+		assert.ErrorIs(t, err, ErrEmpty)
+
+		for _, v := range []string{"a", "b", "c"} {
+			assert.NoError(t, dq.Enqueue(v))
+		}
+		front, err := dq.Peek()
+		assert.NoError(t, err)
+		assert.Equal(t, "a", front)
+
+		lease, err := dq.Dequeue()
+		assert.NoError(t, err)
+		assert.Equal(t, "a", lease.Value)
+		assert.Equal(t, 2, dq.Size())
+		assert.Equal(t, 1, dq.InFlight())
+
+		// Nack puts the element back at the front
+		assert.NoError(t, dq.Nack(lease))
+		assert.ErrorIs(t, dq.Nack(lease), ErrInvalidHandle)
+		assert.Equal(t, []string{"a", "b", "c"}, dequeueAll(t, dq, true))
+
+		assert.ErrorIs(t, dq.Ack(lease), ErrInvalidHandle)
+		assert.Equal(t, 0, dq.InFlight())
+	})
+
+	t.Run("Survives reopen", func(t *testing.T) {
+		dir := t.TempDir()
+		dq := openTestQueue(t, dir, DurableQueueOptions[string]{Sync: SyncBatch, SyncBatchSize: 2})
+		for _, v := range []string{"a", "b", "c", "d"} {
+			assert.NoError(t, dq.Enqueue(v))
+		}
+		acked, _ := dq.Dequeue()
+		assert.NoError(t, dq.Ack(acked))
+		unacked, _ := dq.Dequeue()
+		assert.Equal(t, "b", unacked.Value)
+		assert.ErrorIs(t, dq.Ack(nil), ErrInvalidHandle)
+		assert.ErrorIs(t, dq.Nack(nil), ErrInvalidHandle)
+		assert.NoError(t, dq.Close())
+		assert.ErrorIs(t, dq.Enqueue("e"), ErrClosed)
+		_, err := dq.Peek()
+		assert.ErrorIs(t, err, ErrClosed)
+
+		// The unacknowledged lease is delivered again, in its original place
+		dq = openTestQueue(t, dir, DurableQueueOptions[string]{})
+		assert.NoError(t, dq.Enqueue("e"))
+		assert.Equal(t, []string{"b", "c", "d", "e"}, dequeueAll(t, dq, true))
+		assert.NoError(t, dq.Close())
+
+		dq = openTestQueue(t, dir, DurableQueueOptions[string]{})
+		defer dq.Close()
+		assert.True(t, dq.IsEmpty())
+	})
+
+	t.Run("Recovers from a torn write", func(t *testing.T) {
+		dir := t.TempDir()
+		dq := openTestQueue(t, dir, DurableQueueOptions[string]{Sync: SyncNone})
+		assert.NoError(t, dq.Enqueue("complete"))
+		assert.NoError(t, dq.Enqueue("torn"))
+		assert.NoError(t, dq.Close())
+
+		// Cut the last record in half as if the process died mid-write
+		path := dq.segmentPath(0)
+		info, err := os.Stat(path)
+		require.NoError(t, err)
+		require.NoError(t, os.Truncate(path, info.Size()-3))
+
+		dq = openTestQueue(t, dir, DurableQueueOptions[string]{})
+		assert.NoError(t, dq.Enqueue("after"))
+		assert.Equal(t, []string{"complete", "after"}, dequeueAll(t, dq, false))
+		assert.NoError(t, dq.Close())
+	})
+
+	t.Run("Stops replay at a corrupt record", func(t *testing.T) {
+		dir := t.TempDir()
+		dq := openTestQueue(t, dir, DurableQueueOptions[string]{SegmentSize: 32})
+		for _, v := range []string{"first", "second", "third", "fourth", "fifth", "sixth"} {
+			assert.NoError(t, dq.Enqueue(v))
+		}
+		assert.NoError(t, dq.Close())
+
+		indexes, err := dq.segmentIndexes()
+		require.NoError(t, err)
+		require.Greater(t, len(indexes), 2)
+
+		// Flip a payload byte in the second segment; it and everything
+		// after it are discarded
+		path := dq.segmentPath(indexes[1])
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		data[len(data)-1] ^= 0xff
+		require.NoError(t, os.WriteFile(path, data, 0o644))
+
+		dq = openTestQueue(t, dir, DurableQueueOptions[string]{SegmentSize: 32})
+		defer dq.Close()
+		values := dequeueAll(t, dq, false)
+		assert.Less(t, len(values), 6)
+		assert.Equal(t, []string{"first", "second", "third", "fourth", "fifth", "sixth"}[:len(values)], values)
+
+		remaining, err := dq.segmentIndexes()
+		require.NoError(t, err)
+		assert.Equal(t, indexes[:2], remaining)
+	})
+
+	t.Run("Rolls over and compacts segments", func(t *testing.T) {
+		dir := t.TempDir()
+		opts := DurableQueueOptions[string]{SegmentSize: 128}
+		dq := openTestQueue(t, dir, opts)
+		for i := 0; i < 50; i++ {
+			assert.NoError(t, dq.Enqueue("element"))
+		}
+		for i := 0; i < 45; i++ {
+			lease, _ := dq.Dequeue()
+			assert.NoError(t, dq.Ack(lease))
+		}
+		leased, _ := dq.Dequeue()
+
+		before, err := dq.segmentIndexes()
+		require.NoError(t, err)
+		assert.Greater(t, len(before), 5)
+
+		assert.NoError(t, dq.Compact())
+		after, err := dq.segmentIndexes()
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(after))
+
+		// Leases survive compaction and can still be acknowledged
+		assert.NoError(t, dq.Ack(leased))
+		assert.NoError(t, dq.Enqueue("new"))
+		assert.NoError(t, dq.Close())
+
+		dq = openTestQueue(t, dir, opts)
+		defer dq.Close()
+		assert.Equal(t, []string{"element", "element", "element", "element", "new"}, dequeueAll(t, dq, false))
+	})
+
+	t.Run("Failed sync keeps written records", func(t *testing.T) {
+		dir := t.TempDir()
+		dq := openTestQueue(t, dir, DurableQueueOptions[string]{})
+		injected := errors.New("injected sync failure")
+		dq.syncFile = func(*os.File) error { return injected }
+
+		// The record reached the log, so the element stays queued
+		assert.ErrorIs(t, dq.Enqueue("a"), injected)
+		assert.Equal(t, 1, dq.Size())
+		lease, err := dq.Dequeue()
+		require.NoError(t, err)
+		assert.ErrorIs(t, dq.Ack(lease), injected)
+		assert.Equal(t, 0, dq.InFlight())
+
+		dq.syncFile = (*os.File).Sync
+		assert.NoError(t, dq.Enqueue("b"))
+		assert.NoError(t, dq.Enqueue("c"))
+		assert.NoError(t, dq.Close())
+
+		dq = openTestQueue(t, dir, DurableQueueOptions[string]{})
+		defer dq.Close()
+		assert.Equal(t, []string{"b", "c"}, dequeueAll(t, dq, false))
+	})
+
+	t.Run("Failed rollover keeps the current segment", func(t *testing.T) {
+		dir := t.TempDir()
+		opts := DurableQueueOptions[string]{SegmentSize: 1}
+		dq := openTestQueue(t, dir, opts)
+
+		// A directory in place of the next segment cannot be opened
+		blocker := dq.segmentPath(1)
+		require.NoError(t, os.Mkdir(blocker, 0o755))
+		assert.Error(t, dq.Enqueue("a"))
+		assert.Equal(t, 1, dq.Size())
+		assert.Error(t, dq.Enqueue("b"))
+
+		require.NoError(t, os.Remove(blocker))
+		assert.NoError(t, dq.Enqueue("c"))
+		assert.NoError(t, dq.Close())
+
+		dq = openTestQueue(t, dir, opts)
+		defer dq.Close()
+		assert.Equal(t, []string{"a", "b", "c"}, dequeueAll(t, dq, false))
+	})
+
+	t.Run("Failed compaction keeps the queue writable", func(t *testing.T) {
+		dir := t.TempDir()
+		dq := openTestQueue(t, dir, DurableQueueOptions[string]{})
+		assert.NoError(t, dq.Enqueue("a"))
+		assert.NoError(t, dq.Compact())
+		assert.NoError(t, dq.Enqueue("b"))
+		lease, err := dq.Dequeue()
+		require.NoError(t, err)
+
+		// A non-empty directory posing as an old segment cannot be removed
+		blocker := dq.segmentPath(0)
+		require.NoError(t, os.Mkdir(blocker, 0o755))
+		require.NoError(t, os.WriteFile(filepath.Join(blocker, "file"), nil, 0o644))
+		assert.Error(t, dq.Compact())
+
+		// The element is acknowledged after its compacted copy was written
+		assert.NoError(t, dq.Ack(lease))
+		assert.NoError(t, dq.Enqueue("c"))
+		assert.NoError(t, dq.Close())
+		require.NoError(t, os.RemoveAll(blocker))
+
+		dq = openTestQueue(t, dir, DurableQueueOptions[string]{})
+		defer dq.Close()
+		assert.Equal(t, []string{"b", "c"}, dequeueAll(t, dq, false))
+	})
+
+	t.Run("Partial compaction does not resurrect acknowledged elements", func(t *testing.T) {
+		dir := t.TempDir()
+		dq := openTestQueue(t, dir, DurableQueueOptions[string]{})
+		require.NoError(t, dq.Close())
+
+		// The segment holding the enqueue was deleted, the old active one
+		// received the ack afterwards and the compacted one still has a copy
+		var payload bytes.Buffer
+		require.NoError(t, DefaultCodec[string]().Encode(&payload, "a"))
+		require.NoError(t, os.WriteFile(dq.segmentPath(1), encodeRecord(recordAck, 1, nil), 0o644))
+		require.NoError(t, os.WriteFile(dq.segmentPath(2), encodeRecord(recordEnqueue, 1, payload.Bytes()), 0o644))
+
+		dq = openTestQueue(t, dir, DurableQueueOptions[string]{})
+		defer dq.Close()
+		assert.True(t, dq.IsEmpty())
+	})
+
+	t.Run("Interrupted compaction does not duplicate elements", func(t *testing.T) {
+		dir := t.TempDir()
+		dq := openTestQueue(t, dir, DurableQueueOptions[string]{})
+		assert.NoError(t, dq.Enqueue("a"))
+		assert.NoError(t, dq.Enqueue("b"))
+		assert.NoError(t, dq.Close())
+
+		// Simulate a crash after the compacted segment was renamed into
+		// place but before the old one was deleted
+		data, err := os.ReadFile(dq.segmentPath(0))
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(dq.segmentPath(1), data, 0o644))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "00000000000000000002.seg.tmp"), []byte("junk"), 0o644))
+
+		dq = openTestQueue(t, dir, DurableQueueOptions[string]{})
+		defer dq.Close()
+		assert.Equal(t, []string{"a", "b"}, dequeueAll(t, dq, false))
+		_, err = os.Stat(filepath.Join(dir, "00000000000000000002.seg.tmp"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("Custom codec", func(t *testing.T) {
+		dir := t.TempDir()
+		opts := DurableQueueOptions[string]{Codec: upperCodec{}}
+		dq := openTestQueue(t, dir, opts)
+		assert.NoError(t, dq.Enqueue("x"))
+		assert.NoError(t, dq.Close())
+
+		dq = openTestQueue(t, dir, opts)
+		defer dq.Close()
+		front, err := dq.Peek()
+		assert.NoError(t, err)
+		assert.Equal(t, "x", front)
+	})
+}
@@ -1,6 +1,10 @@
 package godatastructures
 
-import "fmt"
+import (
+	"fmt"
+	"iter"
+	"slices"
+)
 
 type DynamicArray[T any] struct {
 	data []T
@@ -10,6 +14,13 @@ func NewDynamicArray[T any](size int) *DynamicArray[T] {
 	return &DynamicArray[T]{data: make([]T, 0, size)}
 }
 
+// DynamicArrayFromSeq returns a new array holding the elements of seq in order.
+func DynamicArrayFromSeq[T any](seq iter.Seq[T]) *DynamicArray[T] {
+	da := NewDynamicArray[T](0)
+	da.Collect(seq)
+	return da
+}
+
 func (da *DynamicArray[T]) Append(item T) {
 	da.data = append(da.data, item)
 }
@@ -17,22 +28,25 @@ func (da *DynamicArray[T]) Append(item T) {
 func (da *DynamicArray[T]) Get(index int) (T, error) {
 	if index >= len(da.data) || index < 0 {
 		var zero T
-		return zero, fmt.Errorf("index out of bounds")
+		return zero, indexError("get", index, len(da.data))
 	}
 	return da.data[index], nil
 }
 
 func (da *DynamicArray[T]) Set(index int, item T) error {
 	if index >= len(da.data) || index < 0 {
-		return fmt.Errorf("index out of bounds")
+		return indexError("set", index, len(da.data))
 	}
 	da.data[index] = item
 	return nil
 }
 
 func (da *DynamicArray[T]) Swap(index1, index2 int) error {
-	if index1 >= len(da.data) || index1 < 0 || index2 >= len(da.data) || index2 < 0 {
-		return fmt.Errorf("index out of bounds")
+	if index1 >= len(da.data) || index1 < 0 {
+		return indexError("swap", index1, len(da.data))
+	}
+	if index2 >= len(da.data) || index2 < 0 {
+		return indexError("swap", index2, len(da.data))
 	}
 	da.data[index1], da.data[index2] = da.data[index2], da.data[index1]
 	return nil
@@ -57,7 +71,7 @@ func (da *DynamicArray[T]) String() string {
 func (da *DynamicArray[T]) Pop() (T, error) {
 	if da.IsEmpty() {
 		var zero T
-		return zero, fmt.Errorf("empty array")
+		return zero, emptyError("array", "pop")
 	}
 	item := da.data[len(da.data)-1]
 	da.data = da.data[:len(da.data)-1]
@@ -65,6 +79,137 @@ func (da *DynamicArray[T]) Pop() (T, error) {
 	return item, nil
 }
 
+// InsertAt inserts items before index, shifting later elements back. index
+// may equal Size() to append.
+func (da *DynamicArray[T]) InsertAt(index int, items ...T) error {
+	if index > len(da.data) || index < 0 {
+		return indexError("insert", index, len(da.data))
+	}
+	da.data = slices.Insert(da.data, index, items...)
+	return nil
+}
+
+// RemoveAt removes and returns the element at index, shifting later elements
+// forward.
+func (da *DynamicArray[T]) RemoveAt(index int) (T, error) {
+	if index >= len(da.data) || index < 0 {
+		var zero T
+		return zero, indexError("remove", index, len(da.data))
+	}
+	item := da.data[index]
+	da.data = slices.Delete(da.data, index, index+1)
+	da.downsize()
+	return item, nil
+}
+
+// RemoveRange removes the elements in [from, to).
+func (da *DynamicArray[T]) RemoveRange(from, to int) error {
+	if err := da.checkRange("remove range", from, to); err != nil {
+		return err
+	}
+	da.data = slices.Delete(da.data, from, to)
+	da.downsize()
+	return nil
+}
+
+// Splice removes deleteCount elements starting at index, inserts items in
+// their place and returns the removed elements as a new array.
+func (da *DynamicArray[T]) Splice(index, deleteCount int, items ...T) (*DynamicArray[T], error) {
+	if deleteCount < 0 {
+		return nil, indexError("splice", index+deleteCount, len(da.data))
+	}
+	if err := da.checkRange("splice", index, index+deleteCount); err != nil {
+		return nil, err
+	}
+	removed := &DynamicArray[T]{data: slices.Clone(da.data[index : index+deleteCount])}
+	da.data = slices.Replace(da.data, index, index+deleteCount, items...)
+	da.downsize()
+	return removed, nil
+}
+
+// Slice returns a new array holding a copy of the elements in [from, to).
+func (da *DynamicArray[T]) Slice(from, to int) (*DynamicArray[T], error) {
+	if err := da.checkRange("slice", from, to); err != nil {
+		return nil, err
+	}
+	return &DynamicArray[T]{data: slices.Clone(da.data[from:to])}, nil
+}
+
+func (da *DynamicArray[T]) Reverse() {
+	slices.Reverse(da.data)
+}
+
+// Sort sorts the array in ascending order according to cmp.
+func (da *DynamicArray[T]) Sort(cmp func(a, b T) int) {
+	slices.SortFunc(da.data, cmp)
+}
+
+// SortStable is like Sort but keeps equal elements in their original order.
+func (da *DynamicArray[T]) SortStable(cmp func(a, b T) int) {
+	slices.SortStableFunc(da.data, cmp)
+}
+
+// HeapSort sorts the array in ascending order according to cmp, in place and
+// in O(n log n) worst case, using the same sift routines as Heap.
+func (da *DynamicArray[T]) HeapSort(cmp func(a, b T) int) {
+	// A max-heap over the unsorted prefix: each step moves the largest
+	// remaining element to the end and shrinks the heap by one.
+	h := &Heap[T]{data: &DynamicArray[T]{data: da.data}, compare: reverseCompare(cmp)}
+	h.heapify()
+	for end := len(da.data) - 1; end > 0; end-- {
+		h.swap(0, end)
+		h.data.data = h.data.data[:end]
+		h.heapifyDown(0)
+	}
+}
+
+func (da *DynamicArray[T]) IsSorted(cmp func(a, b T) int) bool {
+	return slices.IsSortedFunc(da.data, cmp)
+}
+
+// BinarySearch searches the sorted array for target. It returns the index of
+// target, or the index where it would be inserted to keep the array sorted,
+// and whether it was found.
+func (da *DynamicArray[T]) BinarySearch(target T, cmp func(a, b T) int) (int, bool) {
+	return slices.BinarySearchFunc(da.data, target, cmp)
+}
+
+// Compact replaces each run of consecutive elements that cmp considers equal
+// with its first element. On a sorted array this removes all duplicates.
+func (da *DynamicArray[T]) Compact(cmp func(a, b T) int) {
+	da.data = slices.CompactFunc(da.data, func(a, b T) bool {
+		return cmp(a, b) == 0
+	})
+	da.downsize()
+}
+
+// Unique sorts the array and removes duplicate elements.
+func (da *DynamicArray[T]) Unique(cmp func(a, b T) int) {
+	da.Sort(cmp)
+	da.Compact(cmp)
+}
+
+// ArrayIndexOf returns the index of the first element of da equal to item, or -1.
+func ArrayIndexOf[T comparable](da *DynamicArray[T], item T) int {
+	return slices.Index(da.data, item)
+}
+
+// ArrayContains reports whether item is present in da.
+func ArrayContains[T comparable](da *DynamicArray[T], item T) bool {
+	return ArrayIndexOf(da, item) >= 0
+}
+
+// checkRange validates the half-open range [from, to) against the array.
+func (da *DynamicArray[T]) checkRange(op string, from, to int) error {
+	if from > len(da.data) || from < 0 {
+		return indexError(op, from, len(da.data))
+	}
+	if to > len(da.data) || to < from {
+		return indexError(op, to, len(da.data))
+	}
+	return nil
+}
+
 func (da *DynamicArray[T]) downsize() {
 	length, capacity := len(da.data), cap(da.data)
 	if length <= capacity/4 {
@@ -73,3 +218,64 @@ func (da *DynamicArray[T]) downsize() {
 		da.data = newSlice
 	}
 }
+
+// Collect appends every element of seq to the array.
+func (da *DynamicArray[T]) Collect(seq iter.Seq[T]) {
+	for item := range seq {
+		da.Append(item)
+	}
+}
+
+// All yields each index and element from front to back. The length is
+// re-read on every step, so elements appended during iteration are visited
+// and iteration ends early if the array shrinks.
+func (da *DynamicArray[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(da.data); i++ {
+			if !yield(i, da.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values yields each element from front to back, with the same mutation
+// semantics as All.
+func (da *DynamicArray[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range da.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields each index and element from back to front. If the array
+// shrinks during iteration, it continues from the new last element.
+func (da *DynamicArray[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(da.data) - 1; i >= 0; i = min(i, len(da.data)) - 1 {
+			if !yield(i, da.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain removes and yields elements from the front until the array is empty
+// or the loop stops. Elements appended during iteration are drained as well.
+func (da *DynamicArray[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !da.IsEmpty() {
+			var zero T
+			item := da.data[0]
+			da.data[0] = zero
+			da.data = da.data[1:]
+			da.downsize()
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
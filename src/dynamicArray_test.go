@@ -1,6 +1,10 @@
 package godatastructures
 
 import (
+	"cmp"
+	"errors"
+	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -210,3 +214,264 @@ func TestNewDynamicArray(t *testing.T) {
 		t.Errorf("Expected 'hello', got '%s' with error: %v", val, err)
 	}
 }
+
+func TestDynamicArray_Iterators(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{1, 2, 3}))
+
+	if got := slices.Collect(arr.Values()); !slices.Equal(got, []int{1, 2, 3}) {
+		t.Errorf("Expected [1 2 3] from Values, got %v", got)
+	}
+
+	var indices, backward []int
+	for i, v := range arr.Backward() {
+		indices = append(indices, i)
+		backward = append(backward, v)
+	}
+	if !slices.Equal(indices, []int{2, 1, 0}) || !slices.Equal(backward, []int{3, 2, 1}) {
+		t.Errorf("Expected Backward to yield (2,3) (1,2) (0,1), got %v %v", indices, backward)
+	}
+
+	// Appending during All is visited
+	var seen []int
+	for i, v := range arr.All() {
+		seen = append(seen, v)
+		if i == 0 {
+			arr.Append(4)
+		}
+	}
+	if !slices.Equal(seen, []int{1, 2, 3, 4}) {
+		t.Errorf("Expected [1 2 3 4] when appending during All, got %v", seen)
+	}
+
+	// Popping during Backward continues from the new last element
+	seen = nil
+	for _, v := range arr.Backward() {
+		seen = append(seen, v)
+		if v == 4 {
+			arr.Pop()
+			arr.Pop()
+		}
+	}
+	if !slices.Equal(seen, []int{4, 2, 1}) {
+		t.Errorf("Expected [4 2 1] when popping during Backward, got %v", seen)
+	}
+}
+
+func TestDynamicArray_Drain(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{1, 2, 3, 4}))
+
+	var drained []int
+	for v := range arr.Drain() {
+		drained = append(drained, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(drained, []int{1, 2}) {
+		t.Errorf("Expected [1 2] drained, got %v", drained)
+	}
+	if arr.String() != "[3 4]" {
+		t.Errorf("Expected remaining [3 4], got %s", arr.String())
+	}
+
+	arr.Collect(slices.Values([]int{5}))
+	if got := slices.Collect(arr.Drain()); !slices.Equal(got, []int{3, 4, 5}) {
+		t.Errorf("Expected [3 4 5] drained, got %v", got)
+	}
+	if !arr.IsEmpty() {
+		t.Error("Array should be empty after Drain")
+	}
+}
+
+func TestDynamicArray_InsertAndRemove(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{1, 4}))
+
+	if err := arr.InsertAt(1, 2, 3); err != nil {
+		t.Errorf("Unexpected error on InsertAt: %v", err)
+	}
+	if err := arr.InsertAt(4, 5); err != nil {
+		t.Errorf("Unexpected error on InsertAt at the end: %v", err)
+	}
+	if err := arr.InsertAt(0, 0); err != nil {
+		t.Errorf("Unexpected error on InsertAt at the front: %v", err)
+	}
+	if arr.String() != "[0 1 2 3 4 5]" {
+		t.Errorf("Expected '[0 1 2 3 4 5]', got '%s'", arr.String())
+	}
+
+	val, err := arr.RemoveAt(2)
+	if err != nil || val != 2 {
+		t.Errorf("Expected 2, got %d with error: %v", val, err)
+	}
+	if err := arr.RemoveRange(0, 2); err != nil {
+		t.Errorf("Unexpected error on RemoveRange: %v", err)
+	}
+	if arr.String() != "[3 4 5]" {
+		t.Errorf("Expected '[3 4 5]', got '%s'", arr.String())
+	}
+
+	if err := arr.InsertAt(4, 0); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange on InsertAt past the end, got %v", err)
+	}
+	if _, err := arr.RemoveAt(3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange on RemoveAt past the end, got %v", err)
+	}
+	if err := arr.RemoveRange(2, 1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange on inverted range, got %v", err)
+	}
+	if err := arr.RemoveRange(0, 4); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange on range past the end, got %v", err)
+	}
+}
+
+func TestDynamicArray_Splice(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+
+	removed, err := arr.Splice(1, 2, 20, 30, 40)
+	if err != nil {
+		t.Errorf("Unexpected error on Splice: %v", err)
+	}
+	if removed.String() != "[2 3]" || arr.String() != "[1 20 30 40 4 5]" {
+		t.Errorf("Expected removed '[2 3]' and '[1 20 30 40 4 5]', got '%s' and '%s'", removed.String(), arr.String())
+	}
+
+	removed, err = arr.Splice(6, 0, 6)
+	if err != nil || removed.Size() != 0 || arr.String() != "[1 20 30 40 4 5 6]" {
+		t.Errorf("Expected an append-only splice, got '%s' with error: %v", arr.String(), err)
+	}
+
+	if _, err := arr.Splice(5, 3); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange on Splice past the end, got %v", err)
+	}
+	if _, err := arr.Splice(0, -1); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange on negative delete count, got %v", err)
+	}
+}
+
+func TestDynamicArray_SliceReverseAndSearch(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]string{"a", "b", "c", "d"}))
+
+	sub, err := arr.Slice(1, 3)
+	if err != nil || sub.String() != "[b c]" {
+		t.Errorf("Expected '[b c]', got '%s' with error: %v", sub.String(), err)
+	}
+
+	// The slice is a copy
+	sub.Set(0, "x")
+	if val, _ := arr.Get(1); val != "b" {
+		t.Errorf("Slice should not share storage, got '%s'", val)
+	}
+	if _, err := arr.Slice(3, 5); !errors.Is(err, ErrIndexOutOfRange) {
+		t.Errorf("Expected ErrIndexOutOfRange on Slice past the end, got %v", err)
+	}
+
+	arr.Reverse()
+	if arr.String() != "[d c b a]" {
+		t.Errorf("Expected '[d c b a]', got '%s'", arr.String())
+	}
+
+	if ArrayIndexOf(arr, "b") != 2 || ArrayIndexOf(arr, "z") != -1 {
+		t.Errorf("Expected ArrayIndexOf 2 and -1, got %d and %d", ArrayIndexOf(arr, "b"), ArrayIndexOf(arr, "z"))
+	}
+	if !ArrayContains(arr, "a") || ArrayContains(arr, "z") {
+		t.Error("Expected ArrayContains to find 'a' and not 'z'")
+	}
+}
+
+func TestDynamicArray_RemoveDownsizes(t *testing.T) {
+	arr := &DynamicArray[int]{}
+	for i := 0; i < 100; i++ {
+		arr.Append(i)
+	}
+	initialCap := cap(arr.data)
+
+	arr.RemoveRange(0, 90)
+
+	if cap(arr.data) >= initialCap {
+		t.Error("Array capacity should have decreased after removing a large range")
+	}
+	if val, _ := arr.Get(0); val != 90 {
+		t.Errorf("Expected 90 at index 0, got %d", val)
+	}
+}
+
+func TestDynamicArray_Sorting(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{5, 2, 8, 1, 9, 3}))
+
+	if arr.IsSorted(cmp.Compare[int]) {
+		t.Error("Unsorted array reported as sorted")
+	}
+	arr.Sort(cmp.Compare[int])
+	if arr.String() != "[1 2 3 5 8 9]" || !arr.IsSorted(cmp.Compare[int]) {
+		t.Errorf("Expected '[1 2 3 5 8 9]', got '%s'", arr.String())
+	}
+
+	idx, found := arr.BinarySearch(5, cmp.Compare[int])
+	if idx != 3 || !found {
+		t.Errorf("Expected 5 at index 3, got %d (found %v)", idx, found)
+	}
+	idx, found = arr.BinarySearch(4, cmp.Compare[int])
+	if idx != 3 || found {
+		t.Errorf("Expected insertion point 3 for 4, got %d (found %v)", idx, found)
+	}
+	idx, found = arr.BinarySearch(10, cmp.Compare[int])
+	if idx != 6 || found {
+		t.Errorf("Expected insertion point 6 for 10, got %d (found %v)", idx, found)
+	}
+}
+
+func TestDynamicArray_SortStable(t *testing.T) {
+	type pair struct {
+		key   int
+		label string
+	}
+	arr := DynamicArrayFromSeq(slices.Values([]pair{{2, "a"}, {1, "b"}, {2, "c"}, {1, "d"}}))
+
+	arr.SortStable(func(a, b pair) int { return cmp.Compare(a.key, b.key) })
+
+	var labels []string
+	for v := range arr.Values() {
+		labels = append(labels, v.label)
+	}
+	if !slices.Equal(labels, []string{"b", "d", "a", "c"}) {
+		t.Errorf("Expected stable order [b d a c], got %v", labels)
+	}
+}
+
+func TestDynamicArray_HeapSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 7, 100, 1000} {
+		arr := NewDynamicArray[int](n)
+		for i := 0; i < n; i++ {
+			arr.Append(rng.Intn(50))
+		}
+		expected := slices.Sorted(arr.Values())
+
+		arr.HeapSort(cmp.Compare[int])
+
+		if got := slices.Collect(arr.Values()); !slices.Equal(got, expected) {
+			t.Errorf("HeapSort of %d elements: expected %v, got %v", n, expected, got)
+		}
+	}
+
+	// Descending order with a reversed comparator
+	arr := DynamicArrayFromSeq(slices.Values([]string{"b", "c", "a"}))
+	arr.HeapSort(func(a, b string) int { return cmp.Compare(b, a) })
+	if arr.String() != "[c b a]" {
+		t.Errorf("Expected '[c b a]', got '%s'", arr.String())
+	}
+}
+
+func TestDynamicArray_CompactAndUnique(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{1, 1, 2, 2, 2, 3, 1, 1}))
+
+	arr.Compact(cmp.Compare[int])
+	if arr.String() != "[1 2 3 1]" {
+		t.Errorf("Expected '[1 2 3 1]', got '%s'", arr.String())
+	}
+
+	arr.Unique(cmp.Compare[int])
+	if arr.String() != "[1 2 3]" {
+		t.Errorf("Expected '[1 2 3]', got '%s'", arr.String())
+	}
+}
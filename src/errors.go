@@ -0,0 +1,73 @@
+package godatastructures
+
+import "fmt"
+
+type Err string
+
+func (err Err) Error() string {
+	return string(err)
+}
+
+// Sentinel errors shared by every container. Use errors.Is to test for them;
+// the structured errors below unwrap to one of these.
+const (
+	ErrEmpty            = Err("empty container")
+	ErrIndexOutOfRange  = Err("index out of range")
+	ErrCapacityExceeded = Err("capacity exceeded")
+	ErrInvalidHandle    = Err("invalid handle")
+	ErrClosed           = Err("container closed")
+	ErrInvalidFormat    = Err("invalid encoded data")
+	ErrUnsorted         = Err("input not sorted")
+)
+
+// EmptyError reports an operation that needs at least one element.
+type EmptyError struct {
+	Container string
+	Op        string
+}
+
+func (e *EmptyError) Error() string {
+	return fmt.Sprintf("empty %s, can't %s", e.Container, e.Op)
+}
+
+func (e *EmptyError) Unwrap() error {
+	return ErrEmpty
+}
+
+// IndexError reports an index outside [0, Length).
+type IndexError struct {
+	Op     string
+	Index  int
+	Length int
+}
+
+func (e *IndexError) Error() string {
+	return fmt.Sprintf("%s: index %d out of range [0, %d)", e.Op, e.Index, e.Length)
+}
+
+func (e *IndexError) Unwrap() error {
+	return ErrIndexOutOfRange
+}
+
+// CapacityError reports an insertion into a container that is already full.
+type CapacityError struct {
+	Container string
+	Op        string
+	Capacity  int
+}
+
+func (e *CapacityError) Error() string {
+	return fmt.Sprintf("%s full (capacity %d), can't %s", e.Container, e.Capacity, e.Op)
+}
+
+func (e *CapacityError) Unwrap() error {
+	return ErrCapacityExceeded
+}
+
+func emptyError(container, op string) error {
+	return &EmptyError{Container: container, Op: op}
+}
+
+func indexError(op string, index, length int) error {
+	return &IndexError{Op: op, Index: index, Length: length}
+}
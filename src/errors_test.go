@@ -0,0 +1,64 @@
+package godatastructures
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrors_Empty(t *testing.T) {
+	stack := NewStack[int]()
+	queue := NewQueue[int]()
+	heap := NewMinHeap[int]()
+	arr := NewDynamicArray[int](0)
+
+	_, errStackPop := stack.Pop()
+	_, errStackPeek := stack.Peek()
+	_, errDequeue := queue.Dequeue()
+	_, errQueuePeek := queue.Peek()
+	_, errRear := queue.Rear()
+	_, errHeapPop := heap.Pop()
+	_, errHeapPeek := heap.Peek()
+	_, errArrPop := arr.Pop()
+
+	for _, err := range []error{errStackPop, errStackPeek, errDequeue, errQueuePeek, errRear, errHeapPop, errHeapPeek, errArrPop} {
+		assert.ErrorIs(t, err, ErrEmpty)
+		assert.NotErrorIs(t, err, ErrIndexOutOfRange)
+	}
+
+	var emptyErr *EmptyError
+	assert.True(t, errors.As(errDequeue, &emptyErr))
+	assert.Equal(t, "queue", emptyErr.Container)
+	assert.Equal(t, "dequeue", emptyErr.Op)
+	assert.Equal(t, "empty queue, can't dequeue", errDequeue.Error())
+}
+
+func TestErrors_IndexOutOfRange(t *testing.T) {
+	arr := NewDynamicArray[int](0)
+	arr.Append(1)
+	arr.Append(2)
+
+	_, err := arr.Get(5)
+	assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+	var indexErr *IndexError
+	assert.True(t, errors.As(err, &indexErr))
+	assert.Equal(t, IndexError{Op: "get", Index: 5, Length: 2}, *indexErr)
+
+	err = arr.Set(-1, 0)
+	assert.True(t, errors.As(err, &indexErr))
+	assert.Equal(t, "set", indexErr.Op)
+	assert.Equal(t, -1, indexErr.Index)
+
+	err = arr.Swap(0, 3)
+	assert.True(t, errors.As(err, &indexErr))
+	assert.Equal(t, 3, indexErr.Index)
+	assert.Equal(t, "swap: index 3 out of range [0, 2)", err.Error())
+}
+
+func TestErrors_Capacity(t *testing.T) {
+	err := error(&CapacityError{Container: "stack", Op: "push", Capacity: 4})
+	assert.ErrorIs(t, err, ErrCapacityExceeded)
+	assert.Equal(t, "stack full (capacity 4), can't push", err.Error())
+}
@@ -0,0 +1,119 @@
+package godatastructures
+
+import "iter"
+
+// boundedStorage is the subset of Deque that BoundedQueue and BoundedStack
+// rely on, so that OverflowOverwrite can store elements in a fixedRing.
+type boundedStorage[T any] interface {
+	PushBack(item T)
+	PopFront() (T, error)
+	PopBack() (T, error)
+	Front() (T, error)
+	Back() (T, error)
+	Size() int
+	IsEmpty() bool
+	Clear()
+	Values() iter.Seq[T]
+}
+
+func newBoundedStorage[T any](capacity int, policy OverflowPolicy) boundedStorage[T] {
+	if policy == OverflowOverwrite {
+		return newFixedRing[T](capacity)
+	}
+	return NewDeque[T]()
+}
+
+// fixedRing is a circular buffer whose slots are all allocated up front.
+// Unlike Deque it never grows or shrinks, and overwrite replaces the oldest
+// element in place once every slot is taken.
+type fixedRing[T any] struct {
+	data []T
+	head int
+	size int
+}
+
+func newFixedRing[T any](capacity int) *fixedRing[T] {
+	return &fixedRing[T]{data: make([]T, capacity)}
+}
+
+// PushBack stores item after the newest element. The ring must not be full.
+func (r *fixedRing[T]) PushBack(item T) {
+	r.data[r.index(r.size)] = item
+	r.size++
+}
+
+// overwrite stores item in the slot of the oldest element, which makes it
+// the newest one. The ring must be full.
+func (r *fixedRing[T]) overwrite(item T) {
+	r.data[r.head] = item
+	r.head = r.index(1)
+}
+
+func (r *fixedRing[T]) PopFront() (T, error) {
+	var zero T
+	if r.size == 0 {
+		return zero, emptyError("ring", "pop front")
+	}
+	item := r.data[r.head]
+	r.data[r.head] = zero
+	r.head = r.index(1)
+	r.size--
+	return item, nil
+}
+
+func (r *fixedRing[T]) PopBack() (T, error) {
+	var zero T
+	if r.size == 0 {
+		return zero, emptyError("ring", "pop back")
+	}
+	tail := r.index(r.size - 1)
+	item := r.data[tail]
+	r.data[tail] = zero
+	r.size--
+	return item, nil
+}
+
+func (r *fixedRing[T]) Front() (T, error) {
+	if r.size == 0 {
+		var zero T
+		return zero, emptyError("ring", "front")
+	}
+	return r.data[r.head], nil
+}
+
+func (r *fixedRing[T]) Back() (T, error) {
+	if r.size == 0 {
+		var zero T
+		return zero, emptyError("ring", "back")
+	}
+	return r.data[r.index(r.size-1)], nil
+}
+
+func (r *fixedRing[T]) Size() int {
+	return r.size
+}
+
+func (r *fixedRing[T]) IsEmpty() bool {
+	return r.size == 0
+}
+
+// Clear empties the ring but keeps its slots.
+func (r *fixedRing[T]) Clear() {
+	clear(r.data)
+	r.head = 0
+	r.size = 0
+}
+
+func (r *fixedRing[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < r.size; i++ {
+			if !yield(r.data[r.index(i)]) {
+				return
+			}
+		}
+	}
+}
+
+func (r *fixedRing[T]) index(offset int) int {
+	return (r.head + offset) % len(r.data)
+}
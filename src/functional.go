@@ -0,0 +1,183 @@
+package godatastructures
+
+import "iter"
+
+// The sequence functions below work with any container through its Values
+// iterator. The container-specific variants return a container of the same
+// kind with the elements in the same order: front to back for DynamicArray
+// and Queue, bottom to top for Stack.
+
+// Map yields f applied to each element of seq.
+func Map[T, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for item := range seq {
+			if !yield(f(item)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter yields the elements of seq accepted by pred.
+func Filter[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range seq {
+			if pred(item) && !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce folds the elements of seq into a single value, starting from
+// initial.
+func Reduce[T, U any](seq iter.Seq[T], initial U, f func(acc U, item T) U) U {
+	acc := initial
+	for item := range seq {
+		acc = f(acc, item)
+	}
+	return acc
+}
+
+// Any reports whether pred accepts at least one element of seq.
+func Any[T any](seq iter.Seq[T], pred func(T) bool) bool {
+	for item := range seq {
+		if pred(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// All reports whether pred accepts every element of seq. It returns true for
+// an empty sequence.
+func All[T any](seq iter.Seq[T], pred func(T) bool) bool {
+	return !Any(seq, func(item T) bool { return !pred(item) })
+}
+
+// Partition splits seq into the elements accepted by pred and the rest,
+// keeping their order.
+func Partition[T any](seq iter.Seq[T], pred func(T) bool) (matched, rest *DynamicArray[T]) {
+	matched, rest = NewDynamicArray[T](0), NewDynamicArray[T](0)
+	for item := range seq {
+		if pred(item) {
+			matched.Append(item)
+		} else {
+			rest.Append(item)
+		}
+	}
+	return matched, rest
+}
+
+// GroupBy collects the elements of seq into arrays keyed by key(element),
+// keeping their order within each group.
+func GroupBy[T any, K comparable](seq iter.Seq[T], key func(T) K) map[K]*DynamicArray[T] {
+	groups := make(map[K]*DynamicArray[T])
+	for item := range seq {
+		k := key(item)
+		group, ok := groups[k]
+		if !ok {
+			group = NewDynamicArray[T](0)
+			groups[k] = group
+		}
+		group.Append(item)
+	}
+	return groups
+}
+
+// Chunk yields consecutive arrays of size elements from seq; the last one may
+// be shorter. It panics if size is less than 1.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[*DynamicArray[T]] {
+	if size < 1 {
+		panic("godatastructures: Chunk size must be positive")
+	}
+	return func(yield func(*DynamicArray[T]) bool) {
+		chunk := NewDynamicArray[T](size)
+		for item := range seq {
+			chunk.Append(item)
+			if chunk.Size() == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = NewDynamicArray[T](size)
+			}
+		}
+		if !chunk.IsEmpty() {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip yields pairs of elements from a and b, stopping when either runs out.
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+		for itemA := range a {
+			itemB, ok := nextB()
+			if !ok || !yield(itemA, itemB) {
+				return
+			}
+		}
+	}
+}
+
+func MapArray[T, U any](da *DynamicArray[T], f func(T) U) *DynamicArray[U] {
+	return DynamicArrayFromSeq(Map(da.Values(), f))
+}
+
+func FilterArray[T any](da *DynamicArray[T], pred func(T) bool) *DynamicArray[T] {
+	return DynamicArrayFromSeq(Filter(da.Values(), pred))
+}
+
+func MapStack[T, U any](s *Stack[T], f func(T) U) *Stack[U] {
+	return StackFromSeq(Map(s.Values(), f))
+}
+
+func FilterStack[T any](s *Stack[T], pred func(T) bool) *Stack[T] {
+	return StackFromSeq(Filter(s.Values(), pred))
+}
+
+func PartitionStack[T any](s *Stack[T], pred func(T) bool) (matched, rest *Stack[T]) {
+	matched, rest = NewStack[T](), NewStack[T]()
+	for item := range s.Values() {
+		if pred(item) {
+			matched.Push(item)
+		} else {
+			rest.Push(item)
+		}
+	}
+	return matched, rest
+}
+
+func MapQueue[T, U any](q *Queue[T], f func(T) U) *Queue[U] {
+	result := newQueueLike[U](q)
+	result.Collect(Map(q.Values(), f))
+	return result
+}
+
+func FilterQueue[T any](q *Queue[T], pred func(T) bool) *Queue[T] {
+	result := newQueueLike[T](q)
+	result.Collect(Filter(q.Values(), pred))
+	return result
+}
+
+func PartitionQueue[T any](q *Queue[T], pred func(T) bool) (matched, rest *Queue[T]) {
+	matched, rest = newQueueLike[T](q), newQueueLike[T](q)
+	for item := range q.Values() {
+		if pred(item) {
+			matched.Enqueue(item)
+		} else {
+			rest.Enqueue(item)
+		}
+	}
+	return matched, rest
+}
+
+// newQueueLike returns an empty queue with the same backing storage as q.
+func newQueueLike[U, T any](q *Queue[T]) *Queue[U] {
+	if q.ring != nil {
+		return NewRingQueue[U]()
+	}
+	return NewQueue[U]()
+}
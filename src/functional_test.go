@@ -0,0 +1,126 @@
+package godatastructures
+
+import (
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func isEven(v int) bool {
+	return v%2 == 0
+}
+
+func TestFunctional_Sequences(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, slices.Collect(Map(arr.Values(), strconv.Itoa)))
+	assert.Equal(t, []int{2, 4}, slices.Collect(Filter(arr.Values(), isEven)))
+	assert.Equal(t, 15, Reduce(arr.Values(), 0, func(acc, v int) int { return acc + v }))
+	assert.Equal(t, "12345", Reduce(arr.Values(), "", func(acc string, v int) string { return acc + strconv.Itoa(v) }))
+
+	assert.True(t, Any(arr.Values(), isEven))
+	assert.False(t, All(arr.Values(), isEven))
+	assert.True(t, All(arr.Values(), func(v int) bool { return v > 0 }))
+	assert.True(t, All(NewDynamicArray[int](0).Values(), isEven))
+
+	// Stopping early propagates to the source
+	var seen []int
+	for v := range Map(arr.Values(), func(v int) int { seen = append(seen, v); return v }) {
+		if v == 2 {
+			break
+		}
+	}
+	assert.Equal(t, []int{1, 2}, seen)
+}
+
+func TestFunctional_PartitionAndGroupBy(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{1, 2, 3, 4, 5, 6}))
+
+	evens, odds := Partition(arr.Values(), isEven)
+	assert.Equal(t, "[2 4 6]", evens.String())
+	assert.Equal(t, "[1 3 5]", odds.String())
+
+	groups := GroupBy(arr.Values(), func(v int) int { return v % 3 })
+	assert.Equal(t, 3, len(groups))
+	assert.Equal(t, "[3 6]", groups[0].String())
+	assert.Equal(t, "[1 4]", groups[1].String())
+	assert.Equal(t, "[2 5]", groups[2].String())
+}
+
+func TestFunctional_ChunkAndZip(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+
+	var chunks []string
+	for chunk := range Chunk(arr.Values(), 2) {
+		chunks = append(chunks, chunk.String())
+	}
+	assert.Equal(t, []string{"[1 2]", "[3 4]", "[5]"}, chunks)
+	assert.Panics(t, func() { Chunk(arr.Values(), 0) })
+
+	names := QueueFromSeq(slices.Values([]string{"a", "b", "c"}))
+	var pairs []string
+	for v, name := range Zip(arr.Values(), names.Values()) {
+		pairs = append(pairs, strconv.Itoa(v)+name)
+	}
+	assert.Equal(t, []string{"1a", "2b", "3c"}, pairs)
+}
+
+func TestFunctional_PreservesContainerKind(t *testing.T) {
+	t.Run("DynamicArray", func(t *testing.T) {
+		arr := DynamicArrayFromSeq(slices.Values([]int{1, 2, 3, 4}))
+		assert.Equal(t, "[10 20 30 40]", MapArray(arr, func(v int) int { return v * 10 }).String())
+		assert.Equal(t, "[2 4]", FilterArray(arr, isEven).String())
+	})
+
+	t.Run("Stack keeps LIFO order", func(t *testing.T) {
+		stack := StackFromSeq(slices.Values([]int{1, 2, 3, 4}))
+
+		mapped := MapStack(stack, strconv.Itoa)
+		top, err := mapped.Pop()
+		assert.Nil(t, err)
+		assert.Equal(t, "4", top)
+
+		filtered := FilterStack(stack, isEven)
+		assert.Equal(t, []int{4, 2}, slices.Collect(filtered.Drain()))
+
+		evens, odds := PartitionStack(stack, isEven)
+		assert.Equal(t, []int{2, 4}, slices.Collect(evens.Values()))
+		oddTop, _ := odds.Peek()
+		assert.Equal(t, 3, oddTop)
+	})
+
+	t.Run("Queue keeps FIFO order", func(t *testing.T) {
+		queue := QueueFromSeq(slices.Values([]int{1, 2, 3, 4}))
+
+		mapped := MapQueue(queue, func(v int) int { return v * v })
+		assert.Equal(t, []int{1, 4, 9, 16}, slices.Collect(mapped.Drain()))
+
+		filtered := FilterQueue(queue, isEven)
+		front, err := filtered.Peek()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, front)
+
+		evens, odds := PartitionQueue(queue, isEven)
+		assert.Equal(t, []int{2, 4}, slices.Collect(evens.Values()))
+		assert.Equal(t, []int{1, 3}, slices.Collect(odds.Values()))
+		assert.Equal(t, 4, queue.Size())
+	})
+
+	t.Run("Queue keeps its backing storage", func(t *testing.T) {
+		ring := NewRingQueue[int]()
+		ring.Collect(slices.Values([]int{1, 2, 3, 4}))
+
+		mapped := MapQueue(ring, func(v int) string { return strconv.Itoa(v) })
+		assert.NotNil(t, mapped.ring)
+		assert.Equal(t, []string{"1", "2", "3", "4"}, slices.Collect(mapped.Values()))
+		assert.NotNil(t, FilterQueue(ring, isEven).ring)
+		evens, odds := PartitionQueue(ring, isEven)
+		assert.NotNil(t, evens.ring)
+		assert.NotNil(t, odds.ring)
+		assert.Equal(t, []int{1, 3}, slices.Collect(odds.Values()))
+
+		assert.Nil(t, MapQueue(NewQueue[int](), strconv.Itoa).ring)
+	})
+}
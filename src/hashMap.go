@@ -0,0 +1,441 @@
+package godatastructures
+
+import (
+	"iter"
+	"math"
+	"reflect"
+)
+
+const (
+	defaultMaxLoadFactor = 0.875
+	minHashMapSlots      = 8
+)
+
+// HashMapOptions configures NewHashMapWithOptions. The zero value uses
+// DefaultHasher, a maximum load factor of 0.875 and no insertion ordering.
+type HashMapOptions[K comparable] struct {
+	// Hasher overrides DefaultHasher. Keys that are equal must hash equally.
+	Hasher func(key K) uint64
+	// MaxLoadFactor is the fraction of slots that may be used before the
+	// table grows. It must be in (0, 1); higher values save memory at the
+	// cost of longer probes.
+	MaxLoadFactor float64
+	// InsertionOrder makes iteration follow insertion order even after
+	// deletions. Without it, deleting an entry moves the most recently
+	// inserted one into its place.
+	InsertionOrder bool
+	// Capacity preallocates room for this many entries.
+	Capacity int
+}
+
+type hashEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	hash    uint64
+	deleted bool
+}
+
+// hashSlot points into the entries slice. entry is the index plus one, so
+// the zero slot is empty. hash holds the low bits of the entry's hash, which
+// give its home slot and rule out most mismatches without touching entries.
+type hashSlot struct {
+	entry uint32
+	hash  uint32
+}
+
+// HashMap is a hash map using open addressing with Robin Hood probing: an
+// entry that is further from its home slot takes the place of one that is
+// closer, which keeps probe sequences short and lets lookups stop early.
+// Entries are stored densely in a slice that the table indexes into, so
+// iteration is fast and, because the default hasher is unseeded, its order
+// depends only on the sequence of operations, making it reproducible.
+// It is not safe for concurrent use.
+type HashMap[K comparable, V any] struct {
+	slots   []hashSlot
+	entries []hashEntry[K, V]
+	size    int
+	hasher  func(key K) uint64
+	maxLoad float64
+	ordered bool
+}
+
+func NewHashMap[K comparable, V any]() *HashMap[K, V] {
+	return NewHashMapWithOptions[K, V](HashMapOptions[K]{})
+}
+
+func NewHashMapWithOptions[K comparable, V any](opts HashMapOptions[K]) *HashMap[K, V] {
+	if opts.MaxLoadFactor == 0 {
+		opts.MaxLoadFactor = defaultMaxLoadFactor
+	}
+	if opts.MaxLoadFactor <= 0 || opts.MaxLoadFactor >= 1 {
+		panic("godatastructures: HashMap load factor must be in (0, 1)")
+	}
+	if opts.Hasher == nil {
+		opts.Hasher = DefaultHasher[K]()
+	}
+	hm := &HashMap[K, V]{
+		hasher:  opts.Hasher,
+		maxLoad: opts.MaxLoadFactor,
+		ordered: opts.InsertionOrder,
+	}
+	hm.Reserve(max(opts.Capacity, 1))
+	return hm
+}
+
+// DefaultHasher returns the hasher HashMap uses when none is configured.
+// Strings, floats and the common integer types are hashed directly. Any
+// other key is hashed by walking its structure with reflection, following
+// the rules of ==, which is exact but slower, so maps with such keys on a
+// hot path deserve a custom Hasher. The hash is not seeded, which keeps
+// iteration reproducible but means the map should not be keyed by untrusted
+// input.
+func DefaultHasher[K comparable]() func(key K) uint64 {
+	var zero K
+	var hasher any
+	switch any(zero).(type) {
+	case string:
+		hasher = hashString
+	case int:
+		hasher = func(key int) uint64 { return mix64(uint64(key)) }
+	case int32:
+		hasher = func(key int32) uint64 { return mix64(uint64(key)) }
+	case int64:
+		hasher = func(key int64) uint64 { return mix64(uint64(key)) }
+	case uint:
+		hasher = func(key uint) uint64 { return mix64(uint64(key)) }
+	case uint32:
+		hasher = func(key uint32) uint64 { return mix64(uint64(key)) }
+	case uint64:
+		hasher = mix64
+	case float32:
+		hasher = func(key float32) uint64 { return mix64(floatBits(float64(key))) }
+	case float64:
+		hasher = func(key float64) uint64 { return mix64(floatBits(key)) }
+	default:
+		hash := reflectHasher(reflect.TypeFor[K]())
+		hasher = func(key K) uint64 {
+			return hash(0, reflect.ValueOf(&key).Elem())
+		}
+	}
+	return hasher.(func(K) uint64)
+}
+
+// reflectHasher returns a function that folds a value of type t into h so
+// that values equal under == always give the same result: floats have -0
+// folded into +0, strings are hashed by content, pointers and channels by
+// address, and interfaces by dynamic type and value. Blank struct fields
+// are skipped because == ignores them.
+func reflectHasher(t reflect.Type) func(h uint64, v reflect.Value) uint64 {
+	switch t.Kind() {
+	case reflect.Bool:
+		return func(h uint64, v reflect.Value) uint64 {
+			if v.Bool() {
+				return mix64(h ^ 1)
+			}
+			return mix64(h)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(h uint64, v reflect.Value) uint64 { return mix64(h ^ uint64(v.Int())) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(h uint64, v reflect.Value) uint64 { return mix64(h ^ v.Uint()) }
+	case reflect.Float32, reflect.Float64:
+		return func(h uint64, v reflect.Value) uint64 { return mix64(h ^ floatBits(v.Float())) }
+	case reflect.Complex64, reflect.Complex128:
+		return func(h uint64, v reflect.Value) uint64 {
+			c := v.Complex()
+			return mix64(mix64(h^floatBits(real(c))) ^ floatBits(imag(c)))
+		}
+	case reflect.String:
+		return func(h uint64, v reflect.Value) uint64 { return mix64(h ^ hashString(v.String())) }
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return func(h uint64, v reflect.Value) uint64 { return mix64(h ^ uint64(v.Pointer())) }
+	case reflect.Array:
+		elem := reflectHasher(t.Elem())
+		return func(h uint64, v reflect.Value) uint64 {
+			for i := range v.Len() {
+				h = elem(h, v.Index(i))
+			}
+			return h
+		}
+	case reflect.Struct:
+		var fields []int
+		var hashers []func(h uint64, v reflect.Value) uint64
+		for i := range t.NumField() {
+			if field := t.Field(i); field.Name != "_" {
+				fields = append(fields, i)
+				hashers = append(hashers, reflectHasher(field.Type))
+			}
+		}
+		return func(h uint64, v reflect.Value) uint64 {
+			for i, field := range fields {
+				h = hashers[i](h, v.Field(field))
+			}
+			return h
+		}
+	case reflect.Interface:
+		return func(h uint64, v reflect.Value) uint64 {
+			if v.IsNil() {
+				return mix64(h)
+			}
+			elem := v.Elem()
+			h = mix64(h ^ hashString(elem.Type().String()))
+			return reflectHasher(elem.Type())(h, elem)
+		}
+	}
+	panic("godatastructures: DefaultHasher cannot hash keys of type " + t.String())
+}
+
+// floatBits returns the bits of f with -0 folded into +0, since the two are
+// equal keys.
+func floatBits(f float64) uint64 {
+	if f == 0 {
+		f = 0
+	}
+	return math.Float64bits(f)
+}
+
+// hashString is FNV-1a followed by a finalizer that spreads the entropy
+// into the low bits the table uses.
+func hashString(s string) uint64 {
+	h := uint64(14695981039346656037)
+	for i := 0; i < len(s); i++ {
+		h ^= uint64(s[i])
+		h *= 1099511628211
+	}
+	return mix64(h)
+}
+
+// mix64 is the splitmix64 finalizer.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+func (hm *HashMap[K, V]) Size() int {
+	return hm.size
+}
+
+func (hm *HashMap[K, V]) IsEmpty() bool {
+	return hm.size == 0
+}
+
+// LoadFactor returns the fraction of slots in use.
+func (hm *HashMap[K, V]) LoadFactor() float64 {
+	return float64(hm.size) / float64(len(hm.slots))
+}
+
+func (hm *HashMap[K, V]) Clear() {
+	clear(hm.slots)
+	clear(hm.entries)
+	hm.entries = hm.entries[:0]
+	hm.size = 0
+}
+
+// Reserve grows the map so that it can hold n entries without rehashing.
+func (hm *HashMap[K, V]) Reserve(n int) {
+	slots := minHashMapSlots
+	for float64(n) > float64(slots)*hm.maxLoad {
+		slots *= 2
+	}
+	if slots > len(hm.slots) {
+		hm.rehash(slots)
+	}
+	if cap(hm.entries) < n {
+		entries := make([]hashEntry[K, V], len(hm.entries), n)
+		copy(entries, hm.entries)
+		hm.entries = entries
+	}
+}
+
+func (hm *HashMap[K, V]) Get(key K) (V, bool) {
+	if pos, ok := hm.find(key, hm.hasher(key)); ok {
+		return hm.entries[hm.slots[pos].entry-1].value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (hm *HashMap[K, V]) Contains(key K) bool {
+	_, ok := hm.find(key, hm.hasher(key))
+	return ok
+}
+
+// Put stores value under key and reports whether the key was new. Replacing
+// a value keeps the key's position in the iteration order.
+func (hm *HashMap[K, V]) Put(key K, value V) bool {
+	hash := hm.hasher(key)
+	if pos, ok := hm.find(key, hash); ok {
+		hm.entries[hm.slots[pos].entry-1].value = value
+		return false
+	}
+	if float64(hm.size+1) > float64(len(hm.slots))*hm.maxLoad {
+		hm.rehash(len(hm.slots) * 2)
+	}
+	hm.entries = append(hm.entries, hashEntry[K, V]{key: key, value: value, hash: hash})
+	hm.place(hashSlot{entry: uint32(len(hm.entries)), hash: uint32(hash)})
+	hm.size++
+	return true
+}
+
+// Delete removes key and returns its value, if it was present.
+func (hm *HashMap[K, V]) Delete(key K) (V, bool) {
+	pos, ok := hm.find(key, hm.hasher(key))
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	index := int(hm.slots[pos].entry - 1)
+	value := hm.entries[index].value
+	hm.unplace(pos)
+	hm.size--
+
+	if hm.ordered {
+		hm.entries[index] = hashEntry[K, V]{deleted: true}
+		if deleted := len(hm.entries) - hm.size; deleted > 16 && deleted > hm.size {
+			hm.rehash(len(hm.slots))
+		}
+		return value, true
+	}
+
+	last := len(hm.entries) - 1
+	if index != last {
+		moved := hm.entries[last]
+		hm.entries[index] = moved
+		hm.slots[hm.slotOf(uint32(last+1), moved.hash)].entry = uint32(index + 1)
+	}
+	hm.entries[last] = hashEntry[K, V]{}
+	hm.entries = hm.entries[:last]
+	return value, true
+}
+
+// All yields every entry, in insertion order if the map was created with
+// InsertionOrder.
+func (hm *HashMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for i := 0; i < len(hm.entries); i++ {
+			entry := &hm.entries[i]
+			if entry.deleted {
+				continue
+			}
+			if !yield(entry.key, entry.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys yields every key in the same order as All.
+func (hm *HashMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range hm.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values yields every value in the same order as All.
+func (hm *HashMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range hm.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+func (hm *HashMap[K, V]) mask() int {
+	return len(hm.slots) - 1
+}
+
+// distance returns how far the slot at pos is from its home slot.
+func (hm *HashMap[K, V]) distance(slot hashSlot, pos int) int {
+	return (pos - int(slot.hash)&hm.mask()) & hm.mask()
+}
+
+// find returns the slot holding key. The probe stops at an empty slot or at
+// one closer to its home than key would be, since Robin Hood insertion
+// would have placed key there.
+func (hm *HashMap[K, V]) find(key K, hash uint64) (int, bool) {
+	mask := hm.mask()
+	pos := int(hash) & mask
+	for dist := 0; ; dist++ {
+		slot := hm.slots[pos]
+		if slot.entry == 0 || hm.distance(slot, pos) < dist {
+			return 0, false
+		}
+		if slot.hash == uint32(hash) && hm.entries[slot.entry-1].key == key {
+			return pos, true
+		}
+		pos = (pos + 1) & mask
+	}
+}
+
+// slotOf returns the slot pointing at the given entry.
+func (hm *HashMap[K, V]) slotOf(entry uint32, hash uint64) int {
+	pos := int(hash) & hm.mask()
+	for hm.slots[pos].entry != entry {
+		pos = (pos + 1) & hm.mask()
+	}
+	return pos
+}
+
+// place inserts slot, displacing entries that are closer to their home.
+func (hm *HashMap[K, V]) place(slot hashSlot) {
+	mask := hm.mask()
+	pos := int(slot.hash) & mask
+	for dist := 0; ; dist++ {
+		current := hm.slots[pos]
+		if current.entry == 0 {
+			hm.slots[pos] = slot
+			return
+		}
+		if d := hm.distance(current, pos); d < dist {
+			hm.slots[pos] = slot
+			slot = current
+			dist = d
+		}
+		pos = (pos + 1) & mask
+	}
+}
+
+// unplace empties the slot at pos and shifts the following displaced
+// entries back by one, so no tombstones are needed.
+func (hm *HashMap[K, V]) unplace(pos int) {
+	mask := hm.mask()
+	for {
+		next := (pos + 1) & mask
+		slot := hm.slots[next]
+		if slot.entry == 0 || hm.distance(slot, next) == 0 {
+			hm.slots[pos] = hashSlot{}
+			return
+		}
+		hm.slots[pos] = slot
+		pos = next
+	}
+}
+
+// rehash rebuilds the table with the given number of slots, dropping the
+// entries left behind by deletions in insertion-order mode.
+func (hm *HashMap[K, V]) rehash(slots int) {
+	if len(hm.entries) != hm.size {
+		live := hm.entries[:0]
+		for _, entry := range hm.entries {
+			if !entry.deleted {
+				live = append(live, entry)
+			}
+		}
+		clear(hm.entries[len(live):])
+		hm.entries = live
+	}
+	hm.slots = make([]hashSlot, slots)
+	for i, entry := range hm.entries {
+		hm.place(hashSlot{entry: uint32(i + 1), hash: uint32(entry.hash)})
+	}
+}
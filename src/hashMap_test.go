@@ -0,0 +1,267 @@
+package godatastructures
+
+import (
+	"maps"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkRobinHood verifies that every slot can be found from its home slot
+// and that the slots point at exactly the live entries.
+func checkRobinHood[K comparable, V any](t *testing.T, hm *HashMap[K, V]) {
+	t.Helper()
+	seen := map[uint32]bool{}
+	for pos, slot := range hm.slots {
+		if slot.entry == 0 {
+			continue
+		}
+		entry := hm.entries[slot.entry-1]
+		require.False(t, entry.deleted)
+		require.Equal(t, uint32(entry.hash), slot.hash)
+		found, ok := hm.find(entry.key, entry.hash)
+		require.True(t, ok)
+		require.Equal(t, pos, found)
+		seen[slot.entry] = true
+	}
+	require.Len(t, seen, hm.Size())
+}
+
+func TestHashMap(t *testing.T) {
+	t.Run("Put, get and delete", func(t *testing.T) {
+		hm := NewHashMap[string, int]()
+		assert.True(t, hm.IsEmpty())
+		assert.True(t, hm.Put("a", 1))
+		assert.True(t, hm.Put("b", 2))
+		assert.False(t, hm.Put("a", 10))
+		assert.Equal(t, 2, hm.Size())
+
+		val, ok := hm.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 10, val)
+		_, ok = hm.Get("c")
+		assert.False(t, ok)
+
+		val, ok = hm.Delete("a")
+		assert.True(t, ok)
+		assert.Equal(t, 10, val)
+		_, ok = hm.Delete("a")
+		assert.False(t, ok)
+		assert.False(t, hm.Contains("a"))
+		assert.True(t, hm.Contains("b"))
+
+		hm.Clear()
+		assert.True(t, hm.IsEmpty())
+		assert.False(t, hm.Contains("b"))
+	})
+
+	t.Run("Matches a map under random operations", func(t *testing.T) {
+		collide := func(key int) uint64 { return uint64(key % 7) }
+		for _, opts := range []HashMapOptions[int]{
+			{},
+			{InsertionOrder: true},
+			{Hasher: collide},
+			{Hasher: collide, InsertionOrder: true, MaxLoadFactor: 0.5},
+		} {
+			rng := rand.New(rand.NewSource(5))
+			hm := NewHashMapWithOptions[int, int](opts)
+			reference := map[int]int{}
+			var order []int
+			for i := range 4000 {
+				key := rng.Intn(300)
+				if rng.Intn(3) == 0 {
+					want, wantOK := reference[key]
+					delete(reference, key)
+					order = slices.DeleteFunc(order, func(k int) bool { return k == key })
+					got, gotOK := hm.Delete(key)
+					require.Equal(t, wantOK, gotOK)
+					require.Equal(t, want, got)
+				} else {
+					if _, ok := reference[key]; !ok {
+						order = append(order, key)
+					}
+					reference[key] = i
+					hm.Put(key, i)
+				}
+			}
+			checkRobinHood(t, hm)
+			assert.Equal(t, reference, maps.Collect(hm.All()))
+			if opts.InsertionOrder {
+				assert.Equal(t, order, slices.Collect(hm.Keys()))
+			}
+		}
+	})
+
+	t.Run("Insertion order survives deletes and updates", func(t *testing.T) {
+		hm := NewHashMapWithOptions[string, int](HashMapOptions[string]{InsertionOrder: true})
+		for i, k := range []string{"z", "y", "x", "w"} {
+			hm.Put(k, i)
+		}
+		hm.Delete("y")
+		hm.Put("z", 100)
+		hm.Put("v", 4)
+		assert.Equal(t, []string{"z", "x", "w", "v"}, slices.Collect(hm.Keys()))
+		assert.Equal(t, []int{100, 2, 3, 4}, slices.Collect(hm.Values()))
+	})
+
+	t.Run("Iteration order is deterministic", func(t *testing.T) {
+		build := func() []int {
+			hm := NewHashMap[int, bool]()
+			for i := range 1000 {
+				hm.Put(i*31, true)
+			}
+			for i := range 200 {
+				hm.Delete(i * 93)
+			}
+			return slices.Collect(hm.Keys())
+		}
+		assert.Equal(t, build(), build())
+	})
+
+	t.Run("Reserve and load factor", func(t *testing.T) {
+		hm := NewHashMapWithOptions[int, int](HashMapOptions[int]{MaxLoadFactor: 0.5})
+		hm.Reserve(1000)
+		slots := len(hm.slots)
+		assert.GreaterOrEqual(t, float64(slots)*0.5, 1000.0)
+		for i := range 1000 {
+			hm.Put(i, i)
+		}
+		assert.Equal(t, slots, len(hm.slots))
+		assert.LessOrEqual(t, hm.LoadFactor(), 0.5)
+
+		for i := 1000; i < slots/2; i++ {
+			hm.Put(i, i)
+		}
+		assert.Equal(t, slots, len(hm.slots))
+		hm.Put(slots/2, 0)
+		assert.Equal(t, 2*slots, len(hm.slots))
+
+		assert.Panics(t, func() {
+			NewHashMapWithOptions[int, int](HashMapOptions[int]{MaxLoadFactor: 1})
+		})
+	})
+
+	t.Run("Default hasher", func(t *testing.T) {
+		type point struct{ X, Y int }
+		hashPoint := DefaultHasher[point]()
+		assert.Equal(t, hashPoint(point{1, 2}), hashPoint(point{1, 2}))
+		assert.NotEqual(t, hashPoint(point{1, 2}), hashPoint(point{2, 1}))
+
+		hashFloat := DefaultHasher[float64]()
+		assert.Equal(t, hashFloat(0), hashFloat(math.Copysign(0, -1)))
+
+		hm := NewHashMap[point, string]()
+		hm.Put(point{1, 2}, "a")
+		val, ok := hm.Get(point{1, 2})
+		assert.True(t, ok)
+		assert.Equal(t, "a", val)
+	})
+
+	t.Run("Default hasher agrees with == for floats", func(t *testing.T) {
+		negZero := math.Copysign(0, -1)
+
+		floats := NewHashMap[float32, int]()
+		floats.Put(0, 1)
+		_, ok := floats.Get(float32(negZero))
+		assert.True(t, ok)
+
+		type reading struct {
+			X     float64
+			Label string
+		}
+		readings := NewHashMap[reading, int]()
+		readings.Put(reading{0, "a"}, 1)
+		_, ok = readings.Get(reading{negZero, "a"})
+		assert.True(t, ok)
+		_, ok = readings.Get(reading{0, "b"})
+		assert.False(t, ok)
+
+		arrays := NewHashMap[[2]complex128, int]()
+		arrays.Put([2]complex128{complex(0, 1)}, 1)
+		_, ok = arrays.Get([2]complex128{complex(negZero, 1), complex(0, negZero)})
+		assert.True(t, ok)
+
+		anys := NewHashMap[any, int]()
+		anys.Put(0.0, 1)
+		anys.Put(0, 2)
+		anys.Put(nil, 3)
+		val, _ := anys.Get(negZero)
+		assert.Equal(t, 1, val)
+		val, _ = anys.Get(0)
+		assert.Equal(t, 2, val)
+		assert.True(t, anys.Contains(nil))
+		assert.Equal(t, 3, anys.Size())
+	})
+
+	t.Run("Default hasher uses identity for pointers", func(t *testing.T) {
+		type node struct{ name string }
+		a, b := &node{"x"}, &node{"x"}
+		hm := NewHashMap[*node, int]()
+		hm.Put(a, 1)
+		assert.True(t, hm.Contains(a))
+		assert.False(t, hm.Contains(b))
+	})
+}
+
+func BenchmarkHashMap(b *testing.B) {
+	const n = 100000
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = i * 7919
+	}
+
+	b.Run("HashMap/Put", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			hm := NewHashMap[int, int]()
+			for _, k := range keys {
+				hm.Put(k, k)
+			}
+		}
+	})
+
+	b.Run("map/Put", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m := map[int]int{}
+			for _, k := range keys {
+				m[k] = k
+			}
+		}
+	})
+
+	hm := NewHashMap[int, int]()
+	m := map[int]int{}
+	for _, k := range keys {
+		hm.Put(k, k)
+		m[k] = k
+	}
+
+	b.Run("HashMap/Get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			hm.Get(keys[i%n])
+		}
+	})
+
+	b.Run("map/Get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_ = m[keys[i%n]]
+		}
+	})
+
+	b.Run("HashMap/Iterate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for range hm.All() {
+			}
+		}
+	})
+
+	b.Run("map/Iterate", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for range m {
+			}
+		}
+	})
+}
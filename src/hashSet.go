@@ -0,0 +1,168 @@
+package godatastructures
+
+import (
+	"iter"
+	"maps"
+)
+
+// HashSet is an unordered set of comparable elements backed by a Go map.
+// The zero value is an empty set ready to use. Operations that combine two
+// sets return a new set and leave both operands unchanged.
+type HashSet[T comparable] struct {
+	items map[T]struct{}
+}
+
+func NewHashSet[T comparable]() *HashSet[T] {
+	return &HashSet[T]{items: make(map[T]struct{})}
+}
+
+// HashSetFromSeq returns a new set holding the elements of seq.
+func HashSetFromSeq[T comparable](seq iter.Seq[T]) *HashSet[T] {
+	s := NewHashSet[T]()
+	s.Collect(seq)
+	return s
+}
+
+// HashSetFromArray returns a new set holding the elements of da, with
+// duplicates removed.
+func HashSetFromArray[T comparable](da *DynamicArray[T]) *HashSet[T] {
+	s := &HashSet[T]{items: make(map[T]struct{}, da.Size())}
+	s.Collect(da.Values())
+	return s
+}
+
+// ToArray returns the elements in a new DynamicArray, in no particular
+// order.
+func (s *HashSet[T]) ToArray() *DynamicArray[T] {
+	da := NewDynamicArray[T](s.Size())
+	da.Collect(s.Values())
+	return da
+}
+
+func (s *HashSet[T]) Size() int {
+	return len(s.items)
+}
+
+func (s *HashSet[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+func (s *HashSet[T]) Clear() {
+	clear(s.items)
+}
+
+func (s *HashSet[T]) Clone() *HashSet[T] {
+	clone := NewHashSet[T]()
+	maps.Copy(clone.items, s.items)
+	return clone
+}
+
+// Add inserts item and reports whether it was not already present.
+func (s *HashSet[T]) Add(item T) bool {
+	if _, ok := s.items[item]; ok {
+		return false
+	}
+	if s.items == nil {
+		s.items = make(map[T]struct{})
+	}
+	s.items[item] = struct{}{}
+	return true
+}
+
+// Remove deletes item and reports whether it was present.
+func (s *HashSet[T]) Remove(item T) bool {
+	if _, ok := s.items[item]; !ok {
+		return false
+	}
+	delete(s.items, item)
+	return true
+}
+
+func (s *HashSet[T]) Contains(item T) bool {
+	_, ok := s.items[item]
+	return ok
+}
+
+// Collect adds every element of seq to the set.
+func (s *HashSet[T]) Collect(seq iter.Seq[T]) {
+	for item := range seq {
+		s.Add(item)
+	}
+}
+
+// Values yields every element in no particular order.
+func (s *HashSet[T]) Values() iter.Seq[T] {
+	return maps.Keys(s.items)
+}
+
+// Union returns the elements in s, other or both.
+func (s *HashSet[T]) Union(other *HashSet[T]) *HashSet[T] {
+	union := s.Clone()
+	union.Collect(other.Values())
+	return union
+}
+
+// Intersection returns the elements in both s and other.
+func (s *HashSet[T]) Intersection(other *HashSet[T]) *HashSet[T] {
+	small, large := s, other
+	if small.Size() > large.Size() {
+		small, large = large, small
+	}
+	intersection := NewHashSet[T]()
+	for item := range small.items {
+		if large.Contains(item) {
+			intersection.items[item] = struct{}{}
+		}
+	}
+	return intersection
+}
+
+// Difference returns the elements in s but not in other.
+func (s *HashSet[T]) Difference(other *HashSet[T]) *HashSet[T] {
+	difference := NewHashSet[T]()
+	for item := range s.items {
+		if !other.Contains(item) {
+			difference.items[item] = struct{}{}
+		}
+	}
+	return difference
+}
+
+// SymmetricDifference returns the elements in exactly one of s and other.
+func (s *HashSet[T]) SymmetricDifference(other *HashSet[T]) *HashSet[T] {
+	result := s.Difference(other)
+	for item := range other.items {
+		if !s.Contains(item) {
+			result.items[item] = struct{}{}
+		}
+	}
+	return result
+}
+
+// IsSubset reports whether every element of s is also in other.
+func (s *HashSet[T]) IsSubset(other *HashSet[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for item := range s.items {
+		if !other.Contains(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset reports whether every element of other is also in s.
+func (s *HashSet[T]) IsSuperset(other *HashSet[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint reports whether s and other have no elements in common.
+func (s *HashSet[T]) IsDisjoint(other *HashSet[T]) bool {
+	return s.Intersection(other).IsEmpty()
+}
+
+// Equal reports whether s and other hold the same elements.
+func (s *HashSet[T]) Equal(other *HashSet[T]) bool {
+	return s.Size() == other.Size() && s.IsSubset(other)
+}
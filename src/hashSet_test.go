@@ -0,0 +1,81 @@
+package godatastructures
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func setOf(items ...int) *HashSet[int] {
+	return HashSetFromSeq(slices.Values(items))
+}
+
+func TestHashSet(t *testing.T) {
+	t.Run("Add, remove and contains", func(t *testing.T) {
+		var s HashSet[string]
+		assert.True(t, s.IsEmpty())
+		assert.False(t, s.Contains("a"))
+		assert.True(t, s.Add("a"))
+		assert.False(t, s.Add("a"))
+		assert.True(t, s.Add("b"))
+		assert.Equal(t, 2, s.Size())
+
+		assert.True(t, s.Remove("a"))
+		assert.False(t, s.Remove("a"))
+		assert.Equal(t, []string{"b"}, slices.Collect(s.Values()))
+
+		s.Clear()
+		assert.True(t, s.IsEmpty())
+	})
+
+	t.Run("Set algebra", func(t *testing.T) {
+		a := setOf(1, 2, 3, 4)
+		b := setOf(3, 4, 5)
+
+		assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, slices.Collect(a.Union(b).Values()))
+		assert.ElementsMatch(t, []int{3, 4}, slices.Collect(a.Intersection(b).Values()))
+		assert.ElementsMatch(t, []int{1, 2}, slices.Collect(a.Difference(b).Values()))
+		assert.ElementsMatch(t, []int{5}, slices.Collect(b.Difference(a).Values()))
+		assert.ElementsMatch(t, []int{1, 2, 5}, slices.Collect(a.SymmetricDifference(b).Values()))
+
+		assert.Equal(t, 4, a.Size())
+		assert.Equal(t, 3, b.Size())
+	})
+
+	t.Run("Comparisons", func(t *testing.T) {
+		a := setOf(1, 2)
+		b := setOf(1, 2, 3)
+
+		assert.True(t, a.IsSubset(b))
+		assert.False(t, b.IsSubset(a))
+		assert.True(t, b.IsSuperset(a))
+		assert.True(t, a.IsSubset(a))
+		assert.True(t, NewHashSet[int]().IsSubset(a))
+
+		assert.True(t, a.IsDisjoint(setOf(4, 5)))
+		assert.False(t, a.IsDisjoint(b))
+
+		assert.True(t, a.Equal(setOf(2, 1)))
+		assert.False(t, a.Equal(b))
+		assert.False(t, a.Equal(setOf(1, 3)))
+	})
+
+	t.Run("Clone is independent", func(t *testing.T) {
+		a := setOf(1)
+		clone := a.Clone()
+		clone.Add(2)
+		assert.Equal(t, 1, a.Size())
+		assert.Equal(t, 2, clone.Size())
+	})
+
+	t.Run("Convert to and from DynamicArray", func(t *testing.T) {
+		arr := DynamicArrayFromSeq(slices.Values([]string{"x", "y", "x", "z"}))
+		s := HashSetFromArray(arr)
+		assert.Equal(t, 3, s.Size())
+
+		back := s.ToArray()
+		assert.Equal(t, 3, back.Size())
+		assert.ElementsMatch(t, []string{"x", "y", "z"}, slices.Collect(back.Values()))
+	})
+}
@@ -0,0 +1,217 @@
+package godatastructures
+
+import "iter"
+
+// Heap is a binary heap ordered by a comparator. compare(a, b) must return a
+// negative number when a should be popped before b, zero when they are
+// equivalent and a positive number otherwise, matching cmp.Compare.
+type Heap[T any] struct {
+	data    *DynamicArray[T]
+	compare func(a, b T) int
+	// moved, when set, is called with every element that lands at a new
+	// index so addressable heaps can track their elements.
+	moved func(item T, index int)
+}
+
+func NewHeap[T any](compare func(a, b T) int) *Heap[T] {
+	return &Heap[T]{data: NewDynamicArray[T](0), compare: compare}
+}
+
+// NewHeapFrom returns a heap ordered by compare holding a copy of items. It
+// uses bottom-up (Floyd) construction, which takes O(n) rather than the
+// O(n log n) of inserting the items one by one.
+func NewHeapFrom[T any](items []T, compare func(a, b T) int) *Heap[T] {
+	data := NewDynamicArray[T](len(items))
+	data.data = append(data.data, items...)
+	h := &Heap[T]{data: data, compare: compare}
+	h.heapify()
+	return h
+}
+
+// HeapifyInPlace rearranges items into heap order according to compare in
+// O(n), without allocating. items[0] then holds the first element to pop.
+func HeapifyInPlace[T any](items []T, compare func(a, b T) int) {
+	h := &Heap[T]{data: &DynamicArray[T]{data: items}, compare: compare}
+	h.heapify()
+}
+
+// HeapFromSeq returns a new heap ordered by compare holding the elements of seq.
+func HeapFromSeq[T any](seq iter.Seq[T], compare func(a, b T) int) *Heap[T] {
+	h := NewHeap(compare)
+	h.Collect(seq)
+	return h
+}
+
+func (h *Heap[T]) Size() int {
+	return h.data.Size()
+}
+
+func (h *Heap[T]) IsEmpty() bool {
+	return h.data.IsEmpty()
+}
+
+func (h *Heap[T]) Peek() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, emptyError("heap", "peek")
+	}
+	return h.data.Get(0)
+}
+
+func (h *Heap[T]) Insert(item T) {
+	h.data.Append(item)
+	if h.moved != nil {
+		h.moved(item, h.Size()-1)
+	}
+	h.heapifyUp(h.Size() - 1)
+}
+
+func (h *Heap[T]) Pop() (T, error) {
+	if h.IsEmpty() {
+		var zero T
+		return zero, emptyError("heap", "pop")
+	}
+	return h.removeAt(0), nil
+}
+
+// Merge adds every element of other to the heap in O(n+m), leaving other
+// unchanged. The elements are ordered by this heap's comparator.
+func (h *Heap[T]) Merge(other *Heap[T]) {
+	h.data.data = append(h.data.data, other.data.data...)
+	h.heapify()
+}
+
+// heapify restores the heap property over the whole backing array by sifting
+// down every internal node, starting from the last one.
+func (h *Heap[T]) heapify() {
+	for i := h.Size()/2 - 1; i >= 0; i-- {
+		h.heapifyDown(i)
+	}
+}
+
+// removeAt removes and returns the element at index, which must be valid.
+func (h *Heap[T]) removeAt(index int) T {
+	last := h.Size() - 1
+	if index != last {
+		h.swap(index, last)
+	}
+	value, _ := h.data.Pop()
+	if index < h.Size() {
+		h.fix(index)
+	}
+	return value
+}
+
+// fix restores the heap property after the element at index changed.
+func (h *Heap[T]) fix(index int) {
+	h.heapifyDown(index)
+	h.heapifyUp(index)
+}
+
+func (h *Heap[T]) swap(index1, index2 int) error {
+	if err := h.data.Swap(index1, index2); err != nil {
+		return err
+	}
+	if h.moved != nil {
+		h.moved(h.data.data[index1], index1)
+		h.moved(h.data.data[index2], index2)
+	}
+	return nil
+}
+
+// Collect inserts every element of seq into the heap.
+func (h *Heap[T]) Collect(seq iter.Seq[T]) {
+	for item := range seq {
+		h.Insert(item)
+	}
+}
+
+// All yields each element with its position in the underlying array, in
+// heap (level) order rather than sorted order. Use Drain for sorted output.
+// Inserting or popping during iteration may reorder elements that have not
+// been visited yet.
+func (h *Heap[T]) All() iter.Seq2[int, T] {
+	return h.data.All()
+}
+
+// Values yields each element in heap (level) order, with the same mutation
+// semantics as All.
+func (h *Heap[T]) Values() iter.Seq[T] {
+	return h.data.Values()
+}
+
+// Drain pops and yields elements in priority order until the heap is empty
+// or the loop stops. Elements inserted during iteration are yielded in order
+// relative to the ones still in the heap.
+func (h *Heap[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !h.IsEmpty() {
+			item, _ := h.Pop()
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+func (h *Heap[T]) heapifyUp(currentIndex int) {
+	items := h.data.data
+	for currentIndex > 0 {
+		parentIndex := parent(currentIndex)
+		if h.compare(items[currentIndex], items[parentIndex]) >= 0 {
+			return
+		}
+		h.swap(currentIndex, parentIndex)
+		currentIndex = parentIndex
+	}
+}
+
+func (h *Heap[T]) heapifyDown(currentIdx int) {
+	// Index the backing slice directly: this loop dominates Pop and heapify,
+	// and the children are bounds-checked explicitly below.
+	items := h.data.data
+	size := len(items)
+
+	// Continue until we reach a leaf node or the heap property is satisfied
+	for {
+		// Get indices of left and right children
+		leftIdx := leftIndex(currentIdx)
+		rightIdx := rightIndex(currentIdx)
+
+		// Assume current node is the smallest initially
+		smallestIdx := currentIdx
+
+		// Check if left child exists and orders before the current node
+		if leftIdx < size && h.compare(items[leftIdx], items[smallestIdx]) < 0 {
+			smallestIdx = leftIdx
+		}
+
+		// Check if right child exists and orders before the current smallest
+		if rightIdx < size && h.compare(items[rightIdx], items[smallestIdx]) < 0 {
+			smallestIdx = rightIdx
+		}
+
+		// If smallest is still the current node, heap property is satisfied
+		if smallestIdx == currentIdx {
+			return
+		}
+
+		// Swap current node with the smallest child
+		h.swap(currentIdx, smallestIdx)
+
+		// Move down to the child we swapped with
+		currentIdx = smallestIdx
+	}
+}
+
+func parent(index int) int {
+	return (index - 1) / 2
+}
+
+func leftIndex(index int) int {
+	return 2*index + 1
+}
+
+func rightIndex(index int) int {
+	return 2*index + 2
+}
@@ -0,0 +1,115 @@
+package godatastructures
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+type job struct {
+	name     string
+	priority int
+}
+
+func compareJobs(a, b job) int {
+	return cmp.Compare(a.priority, b.priority)
+}
+
+func TestHeap_CustomComparator(t *testing.T) {
+	heap := NewHeap(compareJobs)
+
+	heap.Insert(job{"build", 3})
+	heap.Insert(job{"deploy", 5})
+	heap.Insert(job{"lint", 1})
+	heap.Insert(job{"test", 2})
+
+	top, err := heap.Peek()
+	if err != nil || top.name != "lint" {
+		t.Errorf("Expected lint on top, got %v with error: %v", top, err)
+	}
+
+	expected := []string{"lint", "test", "build", "deploy"}
+	for _, e := range expected {
+		val, err := heap.Pop()
+		if err != nil || val.name != e {
+			t.Errorf("Expected %s, got %v with error: %v", e, val, err)
+		}
+	}
+
+	if !heap.IsEmpty() {
+		t.Error("Heap should be empty after all Pops")
+	}
+}
+
+func TestHeap_ReverseComparator(t *testing.T) {
+	heap := HeapFromSeq(slices.Values([]string{"b", "d", "a", "c"}), func(a, b string) int {
+		return cmp.Compare(b, a)
+	})
+
+	if got := slices.Collect(heap.Drain()); !slices.Equal(got, []string{"d", "c", "b", "a"}) {
+		t.Errorf("Expected [d c b a], got %v", got)
+	}
+}
+
+func TestHeap_ErrorCases(t *testing.T) {
+	heap := NewHeap(compareJobs)
+
+	if _, err := heap.Peek(); err == nil {
+		t.Error("Expected error on Peek from empty heap")
+	}
+	if _, err := heap.Pop(); err == nil {
+		t.Error("Expected error on Pop from empty heap")
+	}
+}
+
+func TestHeap_NewHeapFrom(t *testing.T) {
+	items := []job{{"build", 3}, {"deploy", 5}, {"lint", 1}, {"test", 2}}
+	heap := NewHeapFrom(items, compareJobs)
+
+	if heap.Size() != len(items) {
+		t.Errorf("Expected size %d, got %d", len(items), heap.Size())
+	}
+	if items[0].name != "build" {
+		t.Error("NewHeapFrom should not modify its input")
+	}
+
+	expected := []string{"lint", "test", "build", "deploy"}
+	for _, e := range expected {
+		val, err := heap.Pop()
+		if err != nil || val.name != e {
+			t.Errorf("Expected %s, got %v with error: %v", e, val, err)
+		}
+	}
+}
+
+func TestHeap_HeapifyInPlace(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	items := make([]int, 500)
+	for i := range items {
+		items[i] = rng.Intn(100)
+	}
+
+	HeapifyInPlace(items, cmp.Compare[int])
+	for i := 1; i < len(items); i++ {
+		if items[parent(i)] > items[i] {
+			t.Fatalf("Heap property violated at index %d: parent %d > child %d", i, items[parent(i)], items[i])
+		}
+	}
+
+	HeapifyInPlace([]int{}, cmp.Compare[int])
+}
+
+func TestHeap_Merge(t *testing.T) {
+	heap := NewHeapFrom([]int{7, 1, 5}, cmp.Compare[int])
+	other := NewHeapFrom([]int{4, 2, 6, 3}, cmp.Compare[int])
+
+	heap.Merge(other)
+
+	if other.Size() != 4 {
+		t.Errorf("Merge should leave other unchanged, got size %d", other.Size())
+	}
+	if got := slices.Collect(heap.Drain()); !slices.Equal(got, []int{1, 2, 3, 4, 5, 6, 7}) {
+		t.Errorf("Expected [1 2 3 4 5 6 7], got %v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package godatastructures
+
+// HeapHandle refers to an element stored in an IndexedHeap. It stays valid
+// until the element is popped or removed.
+type HeapHandle[T any] struct {
+	value T
+	index int
+	owner *IndexedHeap[T]
+}
+
+func (hh *HeapHandle[T]) Value() T {
+	return hh.value
+}
+
+// IndexedHeap is an addressable heap: Insert returns a handle that can later
+// be used to change the element's priority or remove it in O(log n).
+type IndexedHeap[T any] struct {
+	heap *Heap[*HeapHandle[T]]
+}
+
+func NewIndexedHeap[T any](compare func(a, b T) int) *IndexedHeap[T] {
+	heap := NewHeap(func(a, b *HeapHandle[T]) int {
+		return compare(a.value, b.value)
+	})
+	heap.moved = func(item *HeapHandle[T], index int) {
+		item.index = index
+	}
+	return &IndexedHeap[T]{heap: heap}
+}
+
+func (ih *IndexedHeap[T]) Size() int {
+	return ih.heap.Size()
+}
+
+func (ih *IndexedHeap[T]) IsEmpty() bool {
+	return ih.heap.IsEmpty()
+}
+
+func (ih *IndexedHeap[T]) Insert(item T) *HeapHandle[T] {
+	handle := &HeapHandle[T]{value: item, owner: ih}
+	ih.heap.Insert(handle)
+	return handle
+}
+
+func (ih *IndexedHeap[T]) Peek() (T, error) {
+	handle, err := ih.heap.Peek()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return handle.value, nil
+}
+
+func (ih *IndexedHeap[T]) Pop() (T, error) {
+	handle, err := ih.heap.Pop()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	handle.owner = nil
+	return handle.value, nil
+}
+
+// Contains reports whether handle refers to an element still in this heap.
+func (ih *IndexedHeap[T]) Contains(handle *HeapHandle[T]) bool {
+	return handle != nil && handle.owner == ih
+}
+
+// Update replaces the element behind handle and restores the heap order,
+// moving it up or down as needed.
+func (ih *IndexedHeap[T]) Update(handle *HeapHandle[T], item T) error {
+	if !ih.Contains(handle) {
+		return ErrInvalidHandle
+	}
+	handle.value = item
+	ih.heap.fix(handle.index)
+	return nil
+}
+
+// Remove deletes the element behind handle and returns it.
+func (ih *IndexedHeap[T]) Remove(handle *HeapHandle[T]) (T, error) {
+	if !ih.Contains(handle) {
+		var zero T
+		return zero, ErrInvalidHandle
+	}
+	ih.heap.removeAt(handle.index)
+	handle.owner = nil
+	return handle.value, nil
+}
@@ -0,0 +1,110 @@
+package godatastructures
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexedHeap(t *testing.T) {
+	t.Run("Insert and Pop", func(t *testing.T) {
+		heap := NewIndexedHeap(cmp.Compare[int])
+		for _, v := range []int{5, 3, 8, 1} {
+			heap.Insert(v)
+		}
+		assert.Equal(t, 4, heap.Size())
+		assert.Equal(t, []int{1, 3, 5, 8}, drainIndexed(heap))
+		assert.True(t, heap.IsEmpty())
+	})
+
+	t.Run("Update moves up and down", func(t *testing.T) {
+		heap := NewIndexedHeap(cmp.Compare[int])
+		handles := map[int]*HeapHandle[int]{}
+		for _, v := range []int{10, 20, 30, 40, 50} {
+			handles[v] = heap.Insert(v)
+		}
+
+		assert.Nil(t, heap.Update(handles[40], 5))
+		top, err := heap.Peek()
+		assert.Nil(t, err)
+		assert.Equal(t, 5, top)
+
+		assert.Nil(t, heap.Update(handles[10], 60))
+		assert.Equal(t, 60, handles[10].Value())
+		assert.Equal(t, []int{5, 20, 30, 50, 60}, drainIndexed(heap))
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		heap := NewIndexedHeap(cmp.Compare[int])
+		handles := map[int]*HeapHandle[int]{}
+		for _, v := range []int{4, 2, 6, 1, 3} {
+			handles[v] = heap.Insert(v)
+		}
+
+		value, err := heap.Remove(handles[2])
+		assert.Nil(t, err)
+		assert.Equal(t, 2, value)
+		assert.False(t, heap.Contains(handles[2]))
+		assert.True(t, heap.Contains(handles[6]))
+
+		_, err = heap.Remove(handles[2])
+		assert.ErrorIs(t, err, ErrInvalidHandle)
+		assert.ErrorIs(t, heap.Update(handles[2], 0), ErrInvalidHandle)
+
+		assert.Equal(t, []int{1, 3, 4, 6}, drainIndexed(heap))
+		assert.False(t, heap.Contains(handles[6]))
+	})
+
+	t.Run("Handles from another heap are rejected", func(t *testing.T) {
+		heap := NewIndexedHeap(cmp.Compare[int])
+		other := NewIndexedHeap(cmp.Compare[int])
+		handle := other.Insert(1)
+
+		assert.False(t, heap.Contains(handle))
+		assert.False(t, heap.Contains(nil))
+		_, err := heap.Remove(handle)
+		assert.ErrorIs(t, err, ErrInvalidHandle)
+	})
+
+	t.Run("Empty heap", func(t *testing.T) {
+		heap := NewIndexedHeap(cmp.Compare[int])
+		_, err := heap.Peek()
+		assert.ErrorIs(t, err, ErrEmpty)
+		_, err = heap.Pop()
+		assert.ErrorIs(t, err, ErrEmpty)
+	})
+
+	t.Run("Random updates and removals", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		heap := NewIndexedHeap(cmp.Compare[int])
+		var handles []*HeapHandle[int]
+		for i := 0; i < 200; i++ {
+			handles = append(handles, heap.Insert(rng.Intn(1000)))
+		}
+		for i := 0; i < 100; i++ {
+			heap.Update(handles[rng.Intn(len(handles))], rng.Intn(1000))
+			heap.Remove(handles[rng.Intn(len(handles))])
+		}
+
+		var expected []int
+		for _, handle := range handles {
+			if heap.Contains(handle) {
+				expected = append(expected, handle.Value())
+			}
+		}
+		slices.Sort(expected)
+		assert.Equal(t, expected, drainIndexed(heap))
+	})
+}
+
+func drainIndexed(heap *IndexedHeap[int]) []int {
+	var result []int
+	for !heap.IsEmpty() {
+		v, _ := heap.Pop()
+		result = append(result, v)
+	}
+	return result
+}
@@ -0,0 +1,245 @@
+package godatastructures
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+// Every container is encoded as a JSON array of its elements: front to back
+// for DynamicArray, Queue and Deque, bottom to top for Stack, in heap
+// (level) order for heaps and in no particular order for HashSet. OrderedMap
+// is the exception and is encoded as a JSON object. Decoding replaces the
+// current contents.
+
+func (da *DynamicArray[T]) MarshalJSON() ([]byte, error) {
+	return marshalItems(da.data)
+}
+
+func (da *DynamicArray[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	da.data = items
+	return nil
+}
+
+func (s *Stack[T]) MarshalJSON() ([]byte, error) {
+	return marshalItems(s.data)
+}
+
+func (s *Stack[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	s.data = items
+	return nil
+}
+
+func (q *Queue[T]) MarshalJSON() ([]byte, error) {
+	return marshalItems(slices.Collect(q.Values()))
+}
+
+func (q *Queue[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	q.Clear()
+	q.Collect(slices.Values(items))
+	return nil
+}
+
+func (d *Deque[T]) MarshalJSON() ([]byte, error) {
+	return marshalItems(slices.Collect(d.Values()))
+}
+
+func (d *Deque[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	d.Clear()
+	d.Collect(slices.Values(items))
+	return nil
+}
+
+func (h *Heap[T]) MarshalJSON() ([]byte, error) {
+	if h.data == nil {
+		return marshalItems[T](nil)
+	}
+	return marshalItems(h.data.data)
+}
+
+// UnmarshalJSON accepts the elements in any order and re-heapifies them. The
+// heap must already have a comparator, as one from NewHeap does.
+func (h *Heap[T]) UnmarshalJSON(data []byte) error {
+	if h.compare == nil {
+		return errors.New("godatastructures: can't unmarshal into a Heap without a comparator")
+	}
+	decoded := NewDynamicArray[T](0)
+	if err := json.Unmarshal(data, decoded); err != nil {
+		return err
+	}
+	h.data = decoded
+	h.heapify()
+	return nil
+}
+
+// UnmarshalJSON accepts the elements in any order and re-heapifies them. It
+// also works on a zero MinHeap.
+func (h *MinHeap[T]) UnmarshalJSON(data []byte) error {
+	h.ensureComparator()
+	return h.Heap.UnmarshalJSON(data)
+}
+
+// UnmarshalJSON accepts the elements in any order and re-heapifies them. It
+// also works on a zero MaxHeap.
+func (h *MaxHeap[T]) UnmarshalJSON(data []byte) error {
+	h.ensureComparator()
+	return h.Heap.UnmarshalJSON(data)
+}
+
+func (s *HashSet[T]) MarshalJSON() ([]byte, error) {
+	return marshalItems(slices.Collect(s.Values()))
+}
+
+// UnmarshalJSON drops duplicate elements.
+func (s *HashSet[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	s.items = make(map[T]struct{}, len(items))
+	s.Collect(slices.Values(items))
+	return nil
+}
+
+// MarshalJSON encodes the map as a JSON object with its keys in iteration
+// order. Keys follow the encoding/json rules for map keys: strings, integers
+// and encoding.TextMarshaler implementations are supported.
+func (om *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for key, value := range om.All() {
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		name, err := marshalMapKey(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedName, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encodedName)
+		buf.WriteByte(':')
+		buf.Write(encodedValue)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object, keeping its keys in document order.
+// If a key is repeated, the last value wins but the key keeps the position
+// of its first occurrence. null decodes to an empty map.
+func (om *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	om.Clear()
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("%w: OrderedMap expects a JSON object, got %v", ErrInvalidFormat, tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, err := unmarshalMapKey[K](tok.(string))
+		if err != nil {
+			return err
+		}
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		om.Set(key, value)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+func marshalMapKey[K comparable](key K) (string, error) {
+	v := reflect.ValueOf(key)
+	if v.Kind() == reflect.String {
+		return v.String(), nil
+	}
+	if tm, ok := any(key).(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	}
+	return "", fmt.Errorf("godatastructures: unsupported map key type %T", key)
+}
+
+func unmarshalMapKey[K comparable](name string) (K, error) {
+	var key K
+	v := reflect.ValueOf(&key).Elem()
+	if v.Kind() == reflect.String {
+		v.SetString(name)
+		return key, nil
+	}
+	if tu, ok := any(&key).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(name))
+		return key, err
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, v.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("%w: map key %q: %v", ErrInvalidFormat, name, err)
+		}
+		v.SetInt(n)
+		return key, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, v.Type().Bits())
+		if err != nil {
+			return key, fmt.Errorf("%w: map key %q: %v", ErrInvalidFormat, name, err)
+		}
+		v.SetUint(n)
+		return key, nil
+	}
+	return key, fmt.Errorf("godatastructures: unsupported map key type %T", key)
+}
+
+// marshalItems encodes items as a JSON array, using [] rather than null for
+// an empty container.
+func marshalItems[T any](items []T) ([]byte, error) {
+	if items == nil {
+		items = []T{}
+	}
+	return json.Marshal(items)
+}
@@ -0,0 +1,167 @@
+package godatastructures
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSON_DynamicArray(t *testing.T) {
+	arr := DynamicArrayFromSeq(slices.Values([]string{"a", "b"}))
+	data, err := json.Marshal(arr)
+	assert.Nil(t, err)
+	assert.Equal(t, `["a","b"]`, string(data))
+
+	var decoded DynamicArray[string]
+	assert.Nil(t, json.Unmarshal([]byte(`["x","y","z"]`), &decoded))
+	assert.Equal(t, "[x y z]", decoded.String())
+
+	data, err = json.Marshal(&DynamicArray[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
+
+func TestJSON_StackAndQueueOrder(t *testing.T) {
+	stack := StackFromSeq(slices.Values([]int{1, 2, 3}))
+	data, err := json.Marshal(stack)
+	assert.Nil(t, err)
+	assert.Equal(t, `[1,2,3]`, string(data))
+
+	var decodedStack Stack[int]
+	assert.Nil(t, json.Unmarshal(data, &decodedStack))
+	top, err := decodedStack.Pop()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, top)
+
+	queue := QueueFromSeq(slices.Values([]int{1, 2, 3}))
+	queue.Dequeue()
+	queue.Enqueue(4)
+	data, err = json.Marshal(queue)
+	assert.Nil(t, err)
+	assert.Equal(t, `[2,3,4]`, string(data))
+
+	ring := NewRingQueue[int]()
+	ring.Enqueue(9)
+	assert.Nil(t, json.Unmarshal(data, ring))
+	assert.NotNil(t, ring.ring)
+	assert.Equal(t, []int{2, 3, 4}, slices.Collect(ring.Drain()))
+
+	deque := NewDeque[int]()
+	deque.PushFront(1)
+	deque.PushBack(2)
+	data, err = json.Marshal(deque)
+	assert.Nil(t, err)
+	assert.Equal(t, `[1,2]`, string(data))
+}
+
+func TestJSON_Heaps(t *testing.T) {
+	var minHeap MinHeap[int]
+	assert.Nil(t, json.Unmarshal([]byte(`[9,4,7,1,8,2]`), &minHeap))
+	assert.Equal(t, []int{1, 2, 4, 7, 8, 9}, slices.Collect(minHeap.Drain()))
+
+	maxHeap := NewMaxHeap[int]()
+	assert.Nil(t, json.Unmarshal([]byte(`[3,9,1]`), maxHeap))
+	data, err := json.Marshal(maxHeap)
+	assert.Nil(t, err)
+
+	var items []int
+	assert.Nil(t, json.Unmarshal(data, &items))
+	assert.Equal(t, 9, items[0])
+
+	heap := NewHeap(compareJobs)
+	assert.Nil(t, json.Unmarshal([]byte(`[]`), heap))
+	assert.True(t, heap.IsEmpty())
+
+	var noComparator Heap[int]
+	assert.NotNil(t, json.Unmarshal([]byte(`[1]`), &noComparator))
+
+	data, err = json.Marshal(NewHeap(cmp.Compare[int]))
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
+
+func TestJSON_HashSet(t *testing.T) {
+	set := HashSetFromSeq(slices.Values([]int{3, 1, 2}))
+	data, err := json.Marshal(set)
+	assert.Nil(t, err)
+
+	var items []int
+	assert.Nil(t, json.Unmarshal(data, &items))
+	assert.ElementsMatch(t, []int{1, 2, 3}, items)
+
+	var decoded HashSet[string]
+	assert.Nil(t, json.Unmarshal([]byte(`["a","b","a"]`), &decoded))
+	assert.Equal(t, 2, decoded.Size())
+	assert.True(t, decoded.Contains("a"))
+
+	data, err = json.Marshal(&HashSet[int]{})
+	assert.Nil(t, err)
+	assert.Equal(t, `[]`, string(data))
+}
+
+func TestJSON_OrderedMap(t *testing.T) {
+	om := NewOrderedMap[string, int]()
+	om.Set("zeta", 1)
+	om.Set("alpha", 2)
+	om.Set("mid\"dle", 3)
+	data, err := json.Marshal(om)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"zeta":1,"alpha":2,"mid\"dle":3}`, string(data))
+
+	var decoded OrderedMap[string, []int]
+	assert.Nil(t, json.Unmarshal([]byte(`{"b": [1], "a": [2, 3], "b": [4]}`), &decoded))
+	assert.Equal(t, []string{"b", "a"}, slices.Collect(decoded.Keys()))
+	val, _ := decoded.Get("b")
+	assert.Equal(t, []int{4}, val)
+
+	assert.Nil(t, json.Unmarshal([]byte(`null`), &decoded))
+	assert.True(t, decoded.IsEmpty())
+	assert.ErrorIs(t, json.Unmarshal([]byte(`[1]`), &decoded), ErrInvalidFormat)
+
+	ints := NewOrderedMap[int, bool]()
+	ints.Set(10, true)
+	ints.Set(-2, false)
+	data, err = json.Marshal(ints)
+	assert.Nil(t, err)
+	assert.Equal(t, `{"10":true,"-2":false}`, string(data))
+
+	var decodedInts OrderedMap[int8, bool]
+	assert.Nil(t, json.Unmarshal(data, &decodedInts))
+	assert.Equal(t, []int8{10, -2}, slices.Collect(decodedInts.Keys()))
+	assert.ErrorIs(t, json.Unmarshal([]byte(`{"300":true}`), &decodedInts), ErrInvalidFormat)
+
+	data, err = json.Marshal(NewOrderedMap[string, int]())
+	assert.Nil(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	type unsupported struct{ A int }
+	badKeys := NewOrderedMap[unsupported, int]()
+	badKeys.Set(unsupported{1}, 1)
+	_, err = json.Marshal(badKeys)
+	assert.NotNil(t, err)
+}
+
+func TestJSON_EmbeddedInStruct(t *testing.T) {
+	type config struct {
+		Jobs     *Queue[string] `json:"jobs"`
+		Priority *MinHeap[int]  `json:"priority"`
+	}
+
+	original := config{Jobs: QueueFromSeq(slices.Values([]string{"build", "test"})), Priority: NewMinHeapFrom([]int{3, 1, 2})}
+	data, err := json.Marshal(original)
+	assert.Nil(t, err)
+
+	var decoded config
+	assert.Nil(t, json.Unmarshal(data, &decoded))
+	front, err := decoded.Jobs.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, "build", front)
+	min, err := decoded.Priority.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, 1, min)
+
+	assert.NotNil(t, json.Unmarshal([]byte(`{"jobs":{}}`), &decoded))
+}
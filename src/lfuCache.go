@@ -0,0 +1,169 @@
+package godatastructures
+
+import "iter"
+
+type lfuEntry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket *ListElement[lfuBucket[K, V]]
+}
+
+// lfuBucket holds every entry accessed exactly freq times, most recently
+// used first.
+type lfuBucket[K comparable, V any] struct {
+	freq    int
+	entries LinkedList[lfuEntry[K, V]]
+}
+
+// LFUCache is a fixed-capacity cache that evicts the least frequently used
+// entry when full, breaking ties by evicting the least recently used one.
+// Entries are grouped into buckets by access count and the buckets are kept
+// in ascending order, so every operation is O(1). It resists the thrashing
+// an LRUCache suffers under large scans. It is not safe for concurrent use.
+type LFUCache[K comparable, V any] struct {
+	items    map[K]*ListElement[lfuEntry[K, V]]
+	buckets  *LinkedList[lfuBucket[K, V]]
+	capacity int
+	stats    CacheStats
+}
+
+func NewLFUCache[K comparable, V any](capacity int) *LFUCache[K, V] {
+	if capacity <= 0 {
+		panic("godatastructures: LFUCache capacity must be positive")
+	}
+	return &LFUCache[K, V]{
+		items:    make(map[K]*ListElement[lfuEntry[K, V]]),
+		buckets:  NewLinkedList[lfuBucket[K, V]](),
+		capacity: capacity,
+	}
+}
+
+// Get returns the value for key and increments its access count.
+func (c *LFUCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	return c.touch(e).Value.value, true
+}
+
+// Peek returns the value for key without changing its access count.
+func (c *LFUCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.Value.value, true
+}
+
+func (c *LFUCache[K, V]) Contains(key K) bool {
+	_, ok := c.items[key]
+	return ok
+}
+
+// Put stores value under key. Replacing a value counts as an access; a new
+// key starts with a count of one, evicting an entry first if the cache is
+// full. Put never fails; it returns an error to satisfy Cache.
+func (c *LFUCache[K, V]) Put(key K, value V) error {
+	if e, ok := c.items[key]; ok {
+		e.Value.value = value
+		c.touch(e)
+		return nil
+	}
+	if len(c.items) >= c.capacity {
+		c.evict()
+	}
+	first := c.buckets.Front()
+	if first == nil || first.Value.freq != 1 {
+		first = c.buckets.PushFront(lfuBucket[K, V]{freq: 1})
+	}
+	c.items[key] = first.Value.entries.PushFront(lfuEntry[K, V]{key: key, value: value, bucket: first})
+	return nil
+}
+
+func (c *LFUCache[K, V]) Remove(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.unlink(e)
+	return e.Value.value, true
+}
+
+func (c *LFUCache[K, V]) Len() int {
+	return len(c.items)
+}
+
+func (c *LFUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+func (c *LFUCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// Frequency returns how many times key has been accessed, or 0 if it is not
+// cached.
+func (c *LFUCache[K, V]) Frequency(key K) int {
+	e, ok := c.items[key]
+	if !ok {
+		return 0
+	}
+	return e.Value.bucket.Value.freq
+}
+
+func (c *LFUCache[K, V]) Clear() {
+	c.buckets.Clear()
+	clear(c.items)
+}
+
+// All yields the entries in eviction order: least frequently used first,
+// and least recently used first within the same frequency.
+func (c *LFUCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for b := c.buckets.Front(); b != nil; b = b.Next() {
+			for e := b.Value.entries.Back(); e != nil; e = e.Prev() {
+				if !yield(e.Value.key, e.Value.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// touch moves e into the bucket for its next frequency and returns its new
+// element.
+func (c *LFUCache[K, V]) touch(e *ListElement[lfuEntry[K, V]]) *ListElement[lfuEntry[K, V]] {
+	bucket := e.Value.bucket
+	next := bucket.Next()
+	if next == nil || next.Value.freq != bucket.Value.freq+1 {
+		next, _ = c.buckets.InsertAfter(lfuBucket[K, V]{freq: bucket.Value.freq + 1}, bucket)
+	}
+	entry, _ := bucket.Value.entries.Remove(e)
+	if bucket.Value.entries.IsEmpty() {
+		c.buckets.Remove(bucket)
+	}
+	entry.bucket = next
+	moved := next.Value.entries.PushFront(entry)
+	c.items[entry.key] = moved
+	return moved
+}
+
+func (c *LFUCache[K, V]) evict() {
+	c.unlink(c.buckets.Front().Value.entries.Back())
+	c.stats.Evictions++
+}
+
+func (c *LFUCache[K, V]) unlink(e *ListElement[lfuEntry[K, V]]) {
+	bucket := e.Value.bucket
+	bucket.Value.entries.Remove(e)
+	if bucket.Value.entries.IsEmpty() {
+		c.buckets.Remove(bucket)
+	}
+	delete(c.items, e.Value.key)
+}
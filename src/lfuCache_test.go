@@ -0,0 +1,99 @@
+package godatastructures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLFUCache(t *testing.T) {
+	t.Run("Evicts the least frequently used entry", func(t *testing.T) {
+		c := NewLFUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Get("a")
+		c.Get("a")
+		c.Get("b")
+
+		c.Put("c", 3)
+		assert.False(t, c.Contains("b"))
+		assert.True(t, c.Contains("a"))
+		assert.Equal(t, 3, c.Frequency("a"))
+		assert.Equal(t, 1, c.Frequency("c"))
+		assert.Equal(t, 0, c.Frequency("b"))
+	})
+
+	t.Run("Ties are broken by recency", func(t *testing.T) {
+		c := NewLFUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Get("b")
+		c.Get("a")
+
+		c.Put("c", 3)
+		assert.False(t, c.Contains("b"))
+
+		var keys []string
+		for k := range c.All() {
+			keys = append(keys, k)
+		}
+		assert.Equal(t, []string{"c", "a"}, keys)
+	})
+
+	t.Run("Peek and replace", func(t *testing.T) {
+		c := NewLFUCache[string, int](2)
+		c.Put("a", 1)
+		val, ok := c.Peek("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+		assert.Equal(t, 1, c.Frequency("a"))
+
+		c.Put("a", 10)
+		assert.Equal(t, 2, c.Frequency("a"))
+		val, _ = c.Get("a")
+		assert.Equal(t, 10, val)
+		assert.Equal(t, 1, c.Len())
+	})
+
+	t.Run("Remove and clear", func(t *testing.T) {
+		c := NewLFUCache[int, int](3)
+		c.Put(1, 1)
+		c.Put(2, 2)
+		c.Get(2)
+		val, ok := c.Remove(2)
+		assert.True(t, ok)
+		assert.Equal(t, 2, val)
+		_, ok = c.Remove(2)
+		assert.False(t, ok)
+		assert.Equal(t, 1, c.buckets.Size())
+
+		c.Clear()
+		assert.Equal(t, 0, c.Len())
+		c.Put(3, 3)
+		assert.Equal(t, 1, c.Frequency(3))
+	})
+
+	t.Run("Survives a scan", func(t *testing.T) {
+		c := NewLFUCache[int, int](10)
+		for i := range 5 {
+			c.Put(i, i)
+			c.Get(i)
+		}
+		for i := 100; i < 1000; i++ {
+			c.Put(i, i)
+		}
+		for i := range 5 {
+			assert.True(t, c.Contains(i))
+		}
+		assert.Equal(t, 10, c.Len())
+	})
+
+	t.Run("Stats", func(t *testing.T) {
+		c := NewLFUCache[int, int](1)
+		c.Put(1, 1)
+		c.Get(1)
+		c.Get(2)
+		c.Put(2, 2)
+		assert.Equal(t, CacheStats{Hits: 1, Misses: 1, Evictions: 1}, c.Stats())
+	})
+}
@@ -0,0 +1,327 @@
+package godatastructures
+
+import "iter"
+
+// ListElement is a handle to a value stored in a LinkedList. It stays valid
+// until the element is removed, including across moves and splices.
+type ListElement[T any] struct {
+	Value T
+	next  *ListElement[T]
+	prev  *ListElement[T]
+	list  *LinkedList[T]
+}
+
+// Next returns the following element, or nil at the back of the list.
+func (e *ListElement[T]) Next() *ListElement[T] {
+	if next := e.next; e.list != nil && next != &e.list.root {
+		return next
+	}
+	return nil
+}
+
+// Prev returns the preceding element, or nil at the front of the list.
+func (e *ListElement[T]) Prev() *ListElement[T] {
+	if prev := e.prev; e.list != nil && prev != &e.list.root {
+		return prev
+	}
+	return nil
+}
+
+// LinkedList is a doubly linked list built around a sentinel element, so
+// every insertion, removal and move through a ListElement is O(1). The zero
+// value is an empty list ready to use.
+type LinkedList[T any] struct {
+	root ListElement[T]
+	size int
+}
+
+func NewLinkedList[T any]() *LinkedList[T] {
+	l := &LinkedList[T]{}
+	l.lazyInit()
+	return l
+}
+
+// LinkedListFromSeq returns a new list with the elements of seq in order.
+func LinkedListFromSeq[T any](seq iter.Seq[T]) *LinkedList[T] {
+	l := NewLinkedList[T]()
+	l.Collect(seq)
+	return l
+}
+
+func (l *LinkedList[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+func (l *LinkedList[T]) Size() int {
+	return l.size
+}
+
+func (l *LinkedList[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Front returns the first element, or nil if the list is empty.
+func (l *LinkedList[T]) Front() *ListElement[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element, or nil if the list is empty.
+func (l *LinkedList[T]) Back() *ListElement[T] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+func (l *LinkedList[T]) Clear() {
+	for e := l.root.next; e != nil && e != &l.root; {
+		next := e.next
+		e.next, e.prev, e.list = nil, nil, nil
+		e = next
+	}
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.size = 0
+}
+
+// link places e after at.
+func (l *LinkedList[T]) link(e, at *ListElement[T]) *ListElement[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.size++
+	return e
+}
+
+func (l *LinkedList[T]) unlink(e *ListElement[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.next, e.prev, e.list = nil, nil, nil
+	l.size--
+}
+
+func (l *LinkedList[T]) move(e, at *ListElement[T]) {
+	if e == at {
+		return
+	}
+	e.prev.next = e.next
+	e.next.prev = e.prev
+
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+}
+
+func (l *LinkedList[T]) PushFront(item T) *ListElement[T] {
+	l.lazyInit()
+	return l.link(&ListElement[T]{Value: item}, &l.root)
+}
+
+func (l *LinkedList[T]) PushBack(item T) *ListElement[T] {
+	l.lazyInit()
+	return l.link(&ListElement[T]{Value: item}, l.root.prev)
+}
+
+// InsertBefore inserts item immediately before mark, which must belong to l.
+func (l *LinkedList[T]) InsertBefore(item T, mark *ListElement[T]) (*ListElement[T], error) {
+	if mark == nil || mark.list != l {
+		return nil, ErrInvalidHandle
+	}
+	return l.link(&ListElement[T]{Value: item}, mark.prev), nil
+}
+
+// InsertAfter inserts item immediately after mark, which must belong to l.
+func (l *LinkedList[T]) InsertAfter(item T, mark *ListElement[T]) (*ListElement[T], error) {
+	if mark == nil || mark.list != l {
+		return nil, ErrInvalidHandle
+	}
+	return l.link(&ListElement[T]{Value: item}, mark), nil
+}
+
+// Remove unlinks e from l and returns its value. The handle is invalid
+// afterwards.
+func (l *LinkedList[T]) Remove(e *ListElement[T]) (T, error) {
+	if e == nil || e.list != l {
+		var zero T
+		return zero, ErrInvalidHandle
+	}
+	l.unlink(e)
+	return e.Value, nil
+}
+
+func (l *LinkedList[T]) PopFront() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, emptyError("list", "pop")
+	}
+	return l.Remove(l.root.next)
+}
+
+func (l *LinkedList[T]) PopBack() (T, error) {
+	if l.size == 0 {
+		var zero T
+		return zero, emptyError("list", "pop")
+	}
+	return l.Remove(l.root.prev)
+}
+
+func (l *LinkedList[T]) MoveToFront(e *ListElement[T]) error {
+	if e == nil || e.list != l {
+		return ErrInvalidHandle
+	}
+	l.move(e, &l.root)
+	return nil
+}
+
+func (l *LinkedList[T]) MoveToBack(e *ListElement[T]) error {
+	if e == nil || e.list != l {
+		return ErrInvalidHandle
+	}
+	l.move(e, l.root.prev)
+	return nil
+}
+
+// MoveBefore moves e to just before mark. Both must belong to l.
+func (l *LinkedList[T]) MoveBefore(e, mark *ListElement[T]) error {
+	if e == nil || mark == nil || e.list != l || mark.list != l {
+		return ErrInvalidHandle
+	}
+	if e != mark {
+		l.move(e, mark.prev)
+	}
+	return nil
+}
+
+// MoveAfter moves e to just after mark. Both must belong to l.
+func (l *LinkedList[T]) MoveAfter(e, mark *ListElement[T]) error {
+	if e == nil || mark == nil || e.list != l || mark.list != l {
+		return ErrInvalidHandle
+	}
+	l.move(e, mark)
+	return nil
+}
+
+// SpliceBack moves every element of other to the back of l, leaving other
+// empty. Handles into other stay valid and now belong to l. No element is
+// allocated or copied, but each handle is rebound, so it runs in
+// O(other.Size()).
+func (l *LinkedList[T]) SpliceBack(other *LinkedList[T]) {
+	l.lazyInit()
+	l.splice(other, l.root.prev)
+}
+
+// SpliceFront is like SpliceBack but moves the elements to the front of l.
+func (l *LinkedList[T]) SpliceFront(other *LinkedList[T]) {
+	l.splice(other, &l.root)
+}
+
+func (l *LinkedList[T]) splice(other *LinkedList[T], at *ListElement[T]) {
+	l.lazyInit()
+	if other == l || other.size == 0 {
+		return
+	}
+	first, last := other.root.next, other.root.prev
+	for e := first; e != &other.root; e = e.next {
+		e.list = l
+	}
+	next := at.next
+	at.next = first
+	first.prev = at
+	last.next = next
+	next.prev = last
+	l.size += other.size
+
+	other.root.next = &other.root
+	other.root.prev = &other.root
+	other.size = 0
+}
+
+// PushBackList appends a copy of every value in other to l. other is left
+// unchanged and may be l itself.
+func (l *LinkedList[T]) PushBackList(other *LinkedList[T]) {
+	l.lazyInit()
+	for i, e := other.size, other.Front(); i > 0; i, e = i-1, e.Next() {
+		l.link(&ListElement[T]{Value: e.Value}, l.root.prev)
+	}
+}
+
+// PushFrontList prepends a copy of every value in other to l, keeping their
+// order. other is left unchanged and may be l itself.
+func (l *LinkedList[T]) PushFrontList(other *LinkedList[T]) {
+	l.lazyInit()
+	for i, e := other.size, other.Back(); i > 0; i, e = i-1, e.Prev() {
+		l.link(&ListElement[T]{Value: e.Value}, &l.root)
+	}
+}
+
+// Collect appends every element of seq to the back of the list.
+func (l *LinkedList[T]) Collect(seq iter.Seq[T]) {
+	for item := range seq {
+		l.PushBack(item)
+	}
+}
+
+// All yields each element with its position, from front to back.
+func (l *LinkedList[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(i, e.Value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values yields each element from front to back.
+func (l *LinkedList[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := l.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields each element with its position, from back to front.
+func (l *LinkedList[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := l.size - 1
+		for e := l.Back(); e != nil; e = e.Prev() {
+			if !yield(i, e.Value) {
+				return
+			}
+			i--
+		}
+	}
+}
+
+// Elements yields each element handle from front to back. The current
+// element may be removed or moved during iteration: the loop ends after the
+// element that was at the back when it started, so moving elements to the
+// back does not make it run forever, although an element moved further
+// ahead is visited again. Removing the element after the current one ends
+// the loop early, without visiting it or anything after it.
+func (l *LinkedList[T]) Elements() iter.Seq[*ListElement[T]] {
+	return func(yield func(*ListElement[T]) bool) {
+		last := l.Back()
+		for e := l.Front(); e != nil; {
+			next := e.Next()
+			if !yield(e) || e == last || (next != nil && next.list != l) {
+				return
+			}
+			e = next
+		}
+	}
+}
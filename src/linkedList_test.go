@@ -0,0 +1,201 @@
+package godatastructures
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLinkedList(t *testing.T) {
+	t.Run("Zero value is usable", func(t *testing.T) {
+		var l LinkedList[int]
+		assert.True(t, l.IsEmpty())
+		assert.Nil(t, l.Front())
+		assert.Nil(t, l.Back())
+		_, err := l.PopFront()
+		assert.ErrorIs(t, err, ErrEmpty)
+
+		l.PushBack(1)
+		l.PushFront(0)
+		assert.Equal(t, []int{0, 1}, slices.Collect(l.Values()))
+	})
+
+	t.Run("Insert relative to a handle", func(t *testing.T) {
+		l := NewLinkedList[string]()
+		b := l.PushBack("b")
+		_, err := l.InsertBefore("a", b)
+		assert.Nil(t, err)
+		_, err = l.InsertAfter("c", b)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"a", "b", "c"}, slices.Collect(l.Values()))
+		assert.Equal(t, "a", b.Prev().Value)
+		assert.Equal(t, "c", b.Next().Value)
+		assert.Nil(t, l.Front().Prev())
+		assert.Nil(t, l.Back().Next())
+	})
+
+	t.Run("Remove and pop", func(t *testing.T) {
+		l := LinkedListFromSeq(slices.Values([]int{1, 2, 3, 4}))
+		second := l.Front().Next()
+		val, err := l.Remove(second)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, val)
+
+		_, err = l.Remove(second)
+		assert.ErrorIs(t, err, ErrInvalidHandle)
+
+		front, _ := l.PopFront()
+		back, _ := l.PopBack()
+		assert.Equal(t, 1, front)
+		assert.Equal(t, 4, back)
+		assert.Equal(t, []int{3}, slices.Collect(l.Values()))
+	})
+
+	t.Run("Move elements", func(t *testing.T) {
+		l := NewLinkedList[int]()
+		one := l.PushBack(1)
+		two := l.PushBack(2)
+		three := l.PushBack(3)
+
+		assert.Nil(t, l.MoveToFront(three))
+		assert.Equal(t, []int{3, 1, 2}, slices.Collect(l.Values()))
+		assert.Nil(t, l.MoveToBack(three))
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(l.Values()))
+		assert.Nil(t, l.MoveBefore(three, one))
+		assert.Equal(t, []int{3, 1, 2}, slices.Collect(l.Values()))
+		assert.Nil(t, l.MoveAfter(three, two))
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(l.Values()))
+		assert.Nil(t, l.MoveAfter(two, two))
+		assert.Equal(t, 3, l.Size())
+	})
+
+	t.Run("Handles from another list are rejected", func(t *testing.T) {
+		l := NewLinkedList[int]()
+		other := NewLinkedList[int]()
+		foreign := other.PushBack(1)
+
+		_, err := l.InsertBefore(0, foreign)
+		assert.ErrorIs(t, err, ErrInvalidHandle)
+		_, err = l.InsertAfter(0, nil)
+		assert.ErrorIs(t, err, ErrInvalidHandle)
+		assert.ErrorIs(t, l.MoveToFront(foreign), ErrInvalidHandle)
+		assert.ErrorIs(t, l.MoveToBack(foreign), ErrInvalidHandle)
+		_, err = l.Remove(foreign)
+		assert.ErrorIs(t, err, ErrInvalidHandle)
+		assert.Equal(t, 1, other.Size())
+	})
+
+	t.Run("Splice moves elements and keeps handles", func(t *testing.T) {
+		l := LinkedListFromSeq(slices.Values([]int{3, 4}))
+		back := LinkedListFromSeq(slices.Values([]int{5, 6}))
+		front := LinkedListFromSeq(slices.Values([]int{1, 2}))
+		handle := back.Front()
+
+		l.SpliceBack(back)
+		l.SpliceFront(front)
+		assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, slices.Collect(l.Values()))
+		assert.True(t, back.IsEmpty())
+		assert.True(t, front.IsEmpty())
+		assert.Nil(t, back.Front())
+
+		assert.Nil(t, l.MoveToFront(handle))
+		assert.Equal(t, 5, l.Front().Value)
+		assert.ErrorIs(t, back.MoveToBack(handle), ErrInvalidHandle)
+
+		l.SpliceBack(l)
+		assert.Equal(t, 6, l.Size())
+	})
+
+	t.Run("Splice into a zero-value list", func(t *testing.T) {
+		var back, front LinkedList[int]
+		back.SpliceBack(LinkedListFromSeq(slices.Values([]int{1, 2})))
+		front.SpliceFront(LinkedListFromSeq(slices.Values([]int{3, 4})))
+		assert.Equal(t, []int{1, 2}, slices.Collect(back.Values()))
+		assert.Equal(t, []int{3, 4}, slices.Collect(front.Values()))
+
+		var empty LinkedList[int]
+		back.SpliceBack(&empty)
+		assert.Equal(t, 2, back.Size())
+	})
+
+	t.Run("Push copies of a list", func(t *testing.T) {
+		l := LinkedListFromSeq(slices.Values([]int{1, 2}))
+		l.PushBackList(l)
+		assert.Equal(t, []int{1, 2, 1, 2}, slices.Collect(l.Values()))
+
+		l = LinkedListFromSeq(slices.Values([]int{3}))
+		l.PushFrontList(LinkedListFromSeq(slices.Values([]int{1, 2})))
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(l.Values()))
+	})
+
+	t.Run("Iterate in both directions", func(t *testing.T) {
+		l := LinkedListFromSeq(slices.Values([]string{"a", "b", "c"}))
+		var forward, backward []int
+		for i, v := range l.All() {
+			forward = append(forward, i)
+			assert.Equal(t, string(rune('a'+i)), v)
+		}
+		for i := range l.Backward() {
+			backward = append(backward, i)
+		}
+		assert.Equal(t, []int{0, 1, 2}, forward)
+		assert.Equal(t, []int{2, 1, 0}, backward)
+	})
+
+	t.Run("Remove while iterating elements", func(t *testing.T) {
+		l := LinkedListFromSeq(slices.Values([]int{1, 2, 3, 4, 5}))
+		for e := range l.Elements() {
+			if e.Value%2 == 0 {
+				l.Remove(e)
+			}
+		}
+		assert.Equal(t, []int{1, 3, 5}, slices.Collect(l.Values()))
+	})
+
+	t.Run("Move while iterating elements", func(t *testing.T) {
+		l := LinkedListFromSeq(slices.Values([]int{1, 2, 3}))
+		var visited []int
+		for e := range l.Elements() {
+			visited = append(visited, e.Value)
+			require.Less(t, len(visited), 10)
+			l.MoveToBack(e)
+		}
+		assert.Equal(t, []int{1, 2, 3}, visited)
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(l.Values()))
+
+		visited = nil
+		for e := range l.Elements() {
+			visited = append(visited, e.Value)
+			require.Less(t, len(visited), 10)
+			l.MoveToFront(e)
+		}
+		assert.Equal(t, []int{1, 2, 3}, visited)
+		assert.Equal(t, []int{3, 2, 1}, slices.Collect(l.Values()))
+	})
+
+	t.Run("Removing the next element ends iteration", func(t *testing.T) {
+		l := LinkedListFromSeq(slices.Values([]int{1, 2, 3}))
+		var visited []int
+		for e := range l.Elements() {
+			visited = append(visited, e.Value)
+			if e.Value == 1 {
+				l.Remove(e.Next())
+				l.Remove(e)
+			}
+		}
+		assert.Equal(t, []int{1}, visited)
+		assert.Equal(t, []int{3}, slices.Collect(l.Values()))
+	})
+
+	t.Run("Clear invalidates handles", func(t *testing.T) {
+		l := NewLinkedList[int]()
+		e := l.PushBack(1)
+		l.Clear()
+		assert.True(t, l.IsEmpty())
+		assert.ErrorIs(t, l.MoveToFront(e), ErrInvalidHandle)
+		l.PushBack(2)
+		assert.Equal(t, []int{2}, slices.Collect(l.Values()))
+	})
+}
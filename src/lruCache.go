@@ -0,0 +1,247 @@
+package godatastructures
+
+import (
+	"iter"
+	"time"
+)
+
+// LRUOptions configures NewLRUCacheWithOptions. The zero value gives every
+// entry a cost of 1, no eviction callback and no expiry.
+type LRUOptions[K comparable, V any] struct {
+	// Cost returns the share of the capacity an entry uses.
+	Cost func(key K, value V) int
+	// OnEvict is called with every entry removed to make room or found
+	// expired. It is not called for Remove, Clear or replaced values.
+	OnEvict func(key K, value V)
+	// TTL is the lifetime given to entries stored with Put. Zero means
+	// entries never expire.
+	TTL time.Duration
+}
+
+type lruEntry[K comparable, V any] struct {
+	key     K
+	value   V
+	cost    int
+	expires time.Time
+}
+
+// LRUCache is a fixed-capacity cache that evicts the least recently used
+// entry when full. Entries live in a LinkedList ordered from most to least
+// recently used and are indexed by a map, so every operation is O(1).
+// Expired entries are removed lazily, when they are next looked up or by
+// RemoveExpired. It is not safe for concurrent use.
+type LRUCache[K comparable, V any] struct {
+	items    map[K]*ListElement[lruEntry[K, V]]
+	order    *LinkedList[lruEntry[K, V]]
+	capacity int
+	used     int
+	opts     LRUOptions[K, V]
+	stats    CacheStats
+	now      func() time.Time
+}
+
+// NewLRUCache returns a cache holding at most capacity entries.
+func NewLRUCache[K comparable, V any](capacity int) *LRUCache[K, V] {
+	return NewLRUCacheWithOptions(capacity, LRUOptions[K, V]{})
+}
+
+// NewLRUCacheWithOptions returns a cache whose entries' total cost never
+// exceeds capacity.
+func NewLRUCacheWithOptions[K comparable, V any](capacity int, opts LRUOptions[K, V]) *LRUCache[K, V] {
+	if capacity <= 0 {
+		panic("godatastructures: LRUCache capacity must be positive")
+	}
+	return &LRUCache[K, V]{
+		items:    make(map[K]*ListElement[lruEntry[K, V]]),
+		order:    NewLinkedList[lruEntry[K, V]](),
+		capacity: capacity,
+		opts:     opts,
+		now:      time.Now,
+	}
+}
+
+// Get returns the value for key and marks it as most recently used.
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	e, ok := c.lookup(key)
+	if !ok {
+		c.stats.Misses++
+		var zero V
+		return zero, false
+	}
+	c.stats.Hits++
+	c.order.MoveToFront(e)
+	return e.Value.value, true
+}
+
+// Peek returns the value for key without changing its recency.
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	e, ok := c.lookup(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return e.Value.value, true
+}
+
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	_, ok := c.lookup(key)
+	return ok
+}
+
+// Put stores value under key as the most recently used entry, evicting
+// least recently used entries until it fits. Entries expire after the
+// configured TTL, if any. It fails only if the entry alone costs more than
+// the capacity.
+func (c *LRUCache[K, V]) Put(key K, value V) error {
+	return c.PutWithTTL(key, value, c.opts.TTL)
+}
+
+// PutWithTTL is like Put but gives the entry its own lifetime. A ttl of zero
+// or less means it never expires.
+func (c *LRUCache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) error {
+	cost := 1
+	if c.opts.Cost != nil {
+		cost = c.opts.Cost(key, value)
+	}
+	if cost > c.capacity {
+		return &CapacityError{Container: "cache", Op: "put", Capacity: c.capacity}
+	}
+	var expires time.Time
+	if ttl > 0 {
+		expires = c.now().Add(ttl)
+	}
+	entry := lruEntry[K, V]{key: key, value: value, cost: cost, expires: expires}
+
+	if e, ok := c.items[key]; ok {
+		c.used += cost - e.Value.cost
+		e.Value = entry
+		c.order.MoveToFront(e)
+	} else {
+		c.items[key] = c.order.PushFront(entry)
+		c.used += cost
+	}
+	c.shrink()
+	return nil
+}
+
+// Remove deletes key and returns its value, if it was present and live.
+func (c *LRUCache[K, V]) Remove(key K) (V, bool) {
+	e, ok := c.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	c.unlink(e)
+	if c.expired(e) {
+		var zero V
+		return zero, false
+	}
+	return e.Value.value, true
+}
+
+// Len returns the number of entries, including expired ones that have not
+// been removed yet.
+func (c *LRUCache[K, V]) Len() int {
+	return c.order.Size()
+}
+
+// Cost returns the total cost of the stored entries.
+func (c *LRUCache[K, V]) Cost() int {
+	return c.used
+}
+
+func (c *LRUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+func (c *LRUCache[K, V]) Clear() {
+	c.order.Clear()
+	clear(c.items)
+	c.used = 0
+}
+
+func (c *LRUCache[K, V]) Stats() CacheStats {
+	return c.stats
+}
+
+// RemoveExpired evicts every expired entry and returns how many there were.
+func (c *LRUCache[K, V]) RemoveExpired() int {
+	removed := 0
+	for e := range c.order.Elements() {
+		if c.expired(e) {
+			c.evict(e)
+			removed++
+		}
+	}
+	return removed
+}
+
+// All yields the live entries from most to least recently used without
+// changing their recency.
+func (c *LRUCache[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		now := c.now()
+		for e := c.order.Front(); e != nil; e = e.Next() {
+			if c.expiredAt(e, now) {
+				continue
+			}
+			if !yield(e.Value.key, e.Value.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys yields the live keys from most to least recently used.
+func (c *LRUCache[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range c.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// lookup finds a live entry, evicting it if it has expired.
+func (c *LRUCache[K, V]) lookup(key K) (*ListElement[lruEntry[K, V]], bool) {
+	e, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	if c.expired(e) {
+		c.evict(e)
+		return nil, false
+	}
+	return e, true
+}
+
+// shrink evicts least recently used entries until the cache is within
+// capacity.
+func (c *LRUCache[K, V]) shrink() {
+	for c.used > c.capacity {
+		c.evict(c.order.Back())
+	}
+}
+
+func (c *LRUCache[K, V]) expired(e *ListElement[lruEntry[K, V]]) bool {
+	return c.expiredAt(e, c.now())
+}
+
+func (c *LRUCache[K, V]) expiredAt(e *ListElement[lruEntry[K, V]], now time.Time) bool {
+	return !e.Value.expires.IsZero() && !now.Before(e.Value.expires)
+}
+
+func (c *LRUCache[K, V]) evict(e *ListElement[lruEntry[K, V]]) {
+	c.unlink(e)
+	c.stats.Evictions++
+	if c.opts.OnEvict != nil {
+		c.opts.OnEvict(e.Value.key, e.Value.value)
+	}
+}
+
+func (c *LRUCache[K, V]) unlink(e *ListElement[lruEntry[K, V]]) {
+	c.order.Remove(e)
+	delete(c.items, e.Value.key)
+	c.used -= e.Value.cost
+}
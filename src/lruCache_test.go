@@ -0,0 +1,138 @@
+package godatastructures
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (fc *fakeClock) Now() time.Time {
+	return fc.now
+}
+
+func (fc *fakeClock) Advance(d time.Duration) {
+	fc.now = fc.now.Add(d)
+}
+
+func TestLRUCache(t *testing.T) {
+	t.Run("Evicts the least recently used entry", func(t *testing.T) {
+		c := NewLRUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		val, ok := c.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+
+		c.Put("c", 3)
+		assert.False(t, c.Contains("b"))
+		assert.Equal(t, 2, c.Len())
+		assert.Equal(t, []string{"c", "a"}, slices.Collect(c.Keys()))
+	})
+
+	t.Run("Peek does not change recency", func(t *testing.T) {
+		c := NewLRUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		val, ok := c.Peek("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+
+		c.Put("c", 3)
+		assert.False(t, c.Contains("a"))
+		_, ok = c.Peek("missing")
+		assert.False(t, ok)
+	})
+
+	t.Run("Put replaces and refreshes an existing key", func(t *testing.T) {
+		c := NewLRUCache[string, int](2)
+		c.Put("a", 1)
+		c.Put("b", 2)
+		c.Put("a", 10)
+		c.Put("c", 3)
+
+		val, ok := c.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, 10, val)
+		assert.False(t, c.Contains("b"))
+	})
+
+	t.Run("Remove and clear", func(t *testing.T) {
+		c := NewLRUCache[int, string](3)
+		c.Put(1, "one")
+		c.Put(2, "two")
+		val, ok := c.Remove(1)
+		assert.True(t, ok)
+		assert.Equal(t, "one", val)
+		_, ok = c.Remove(1)
+		assert.False(t, ok)
+
+		c.Clear()
+		assert.Equal(t, 0, c.Len())
+		assert.Equal(t, 0, c.Cost())
+	})
+
+	t.Run("Capacity by cost", func(t *testing.T) {
+		var evicted []string
+		c := NewLRUCacheWithOptions(10, LRUOptions[string, string]{
+			Cost:    func(_ string, v string) int { return len(v) },
+			OnEvict: func(k string, _ string) { evicted = append(evicted, k) },
+		})
+		assert.Nil(t, c.Put("a", "aaaa"))
+		assert.Nil(t, c.Put("b", "bbbb"))
+		assert.Equal(t, 8, c.Cost())
+
+		assert.Nil(t, c.Put("c", "cccccc"))
+		assert.Equal(t, []string{"a"}, evicted)
+		assert.Equal(t, 10, c.Cost())
+
+		err := c.Put("huge", "xxxxxxxxxxx")
+		assert.ErrorIs(t, err, ErrCapacityExceeded)
+		assert.True(t, c.Contains("c"))
+
+		assert.Nil(t, c.Put("c", "cc"))
+		assert.Equal(t, 6, c.Cost())
+	})
+
+	t.Run("Entries expire lazily", func(t *testing.T) {
+		clock := &fakeClock{now: time.Unix(1000, 0)}
+		var evicted []string
+		c := NewLRUCacheWithOptions(3, LRUOptions[string, int]{
+			TTL:     time.Minute,
+			OnEvict: func(k string, _ int) { evicted = append(evicted, k) },
+		})
+		c.now = clock.Now
+
+		c.Put("a", 1)
+		c.PutWithTTL("b", 2, time.Hour)
+		c.PutWithTTL("c", 3, 0)
+
+		clock.Advance(59 * time.Second)
+		_, ok := c.Get("a")
+		assert.True(t, ok)
+
+		clock.Advance(time.Second)
+		assert.Equal(t, 3, c.Len())
+		assert.Equal(t, []string{"c", "b"}, slices.Collect(c.Keys()))
+		_, ok = c.Get("a")
+		assert.False(t, ok)
+		assert.Equal(t, []string{"a"}, evicted)
+		assert.Equal(t, 2, c.Len())
+
+		clock.Advance(2 * time.Hour)
+		assert.Equal(t, 1, c.RemoveExpired())
+		assert.Equal(t, []string{"a", "b"}, evicted)
+		val, ok := c.Get("c")
+		assert.True(t, ok)
+		assert.Equal(t, 3, val)
+	})
+
+	t.Run("Panics on non-positive capacity", func(t *testing.T) {
+		assert.Panics(t, func() { NewLRUCache[int, int](0) })
+	})
+}
@@ -0,0 +1,48 @@
+package godatastructures
+
+import (
+	"cmp"
+	"iter"
+
+	"golang.org/x/exp/constraints"
+)
+
+// MaxHeap is a Heap that pops its largest element first.
+type MaxHeap[T constraints.Ordered] struct {
+	Heap[T]
+}
+
+func NewMaxHeap[T constraints.Ordered]() *MaxHeap[T] {
+	return &MaxHeap[T]{Heap: *NewHeap(reverseCompare(cmp.Compare[T]))}
+}
+
+// NewMaxHeapFrom returns a heap holding a copy of items, built in O(n).
+func NewMaxHeapFrom[T constraints.Ordered](items []T) *MaxHeap[T] {
+	return &MaxHeap[T]{Heap: *NewHeapFrom(items, reverseCompare(cmp.Compare[T]))}
+}
+
+// MaxHeapFromSeq returns a new heap holding the elements of seq.
+func MaxHeapFromSeq[T constraints.Ordered](seq iter.Seq[T]) *MaxHeap[T] {
+	h := NewMaxHeap[T]()
+	h.Collect(seq)
+	return h
+}
+
+func reverseCompare[T any](compare func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return compare(b, a)
+	}
+}
+
+// Merge adds every element of other to the heap in O(n+m), leaving other
+// unchanged.
+func (h *MaxHeap[T]) Merge(other *MaxHeap[T]) {
+	h.Heap.Merge(&other.Heap)
+}
+
+// ensureComparator initializes a zero MaxHeap so it can be decoded into.
+func (h *MaxHeap[T]) ensureComparator() {
+	if h.compare == nil {
+		h.Heap = NewMaxHeap[T]().Heap
+	}
+}
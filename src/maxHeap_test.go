@@ -0,0 +1,51 @@
+package godatastructures
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMaxHeap_BasicOperations(t *testing.T) {
+	heap := NewMaxHeap[int]()
+
+	if !heap.IsEmpty() {
+		t.Error("New heap should be empty")
+	}
+
+	for _, v := range []int{5, 3, 8, 1, 2, 7, 6, 4} {
+		heap.Insert(v)
+	}
+
+	max, err := heap.Peek()
+	if err != nil || max != 8 {
+		t.Errorf("Expected max element 8, got %d with error: %v", max, err)
+	}
+
+	for e := 8; e >= 1; e-- {
+		val, err := heap.Pop()
+		if err != nil || val != e {
+			t.Errorf("Expected %d, got %d with error: %v", e, val, err)
+		}
+	}
+
+	if _, err := heap.Pop(); err == nil {
+		t.Error("Expected error on Pop from empty heap")
+	}
+}
+
+func TestMaxHeap_FromSeq(t *testing.T) {
+	heap := MaxHeapFromSeq(slices.Values([]string{"banana", "apple", "cherry"}))
+
+	if got := slices.Collect(heap.Drain()); !slices.Equal(got, []string{"cherry", "banana", "apple"}) {
+		t.Errorf("Expected [cherry banana apple], got %v", got)
+	}
+}
+
+func TestMaxHeap_NewMaxHeapFromAndMerge(t *testing.T) {
+	heap := NewMaxHeapFrom([]int{2, 9, 4})
+	heap.Merge(NewMaxHeapFrom([]int{7, 1}))
+
+	if got := slices.Collect(heap.Drain()); !slices.Equal(got, []int{9, 7, 4, 2, 1}) {
+		t.Errorf("Expected [9 7 4 2 1], got %v", got)
+	}
+}
@@ -1,6 +1,7 @@
 package godatastructures
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -332,3 +333,81 @@ func TestMinHeap_MixedOperations(t *testing.T) {
 		}
 	}
 }
+
+func TestMinHeap_Iterators(t *testing.T) {
+	heap := MinHeapFromSeq(slices.Values([]int{5, 3, 8, 1}))
+
+	values := slices.Collect(heap.Values())
+	if len(values) != 4 || values[0] != 1 {
+		t.Errorf("Expected 4 values with the minimum first, got %v", values)
+	}
+	slices.Sort(values)
+	if !slices.Equal(values, []int{1, 3, 5, 8}) {
+		t.Errorf("Expected values [1 3 5 8], got %v", values)
+	}
+
+	var drained []int
+	for v := range heap.Drain() {
+		drained = append(drained, v)
+		if v == 3 {
+			heap.Insert(4)
+		}
+	}
+	if !slices.Equal(drained, []int{1, 3, 4, 5, 8}) {
+		t.Errorf("Expected [1 3 4 5 8] drained, got %v", drained)
+	}
+	if !heap.IsEmpty() {
+		t.Error("Heap should be empty after Drain")
+	}
+}
+
+func TestMinHeap_NewMinHeapFrom(t *testing.T) {
+	heap := NewMinHeapFrom([]int{5, 3, 8, 1, 2, 7, 6, 4})
+
+	for e := 1; e <= 8; e++ {
+		val, err := heap.Pop()
+		if err != nil || val != e {
+			t.Errorf("Expected %d, got %d with error: %v", e, val, err)
+		}
+	}
+
+	empty := NewMinHeapFrom[int](nil)
+	if !empty.IsEmpty() {
+		t.Error("Heap built from nil should be empty")
+	}
+	empty.Insert(1)
+	if val, err := empty.Peek(); err != nil || val != 1 {
+		t.Errorf("Expected 1, got %d with error: %v", val, err)
+	}
+}
+
+func TestMinHeap_Merge(t *testing.T) {
+	heap := NewMinHeapFrom([]int{9, 3, 5})
+	heap.Merge(NewMinHeapFrom([]int{4, 1, 8}))
+
+	if got := slices.Collect(heap.Drain()); !slices.Equal(got, []int{1, 3, 4, 5, 8, 9}) {
+		t.Errorf("Expected [1 3 4 5 8 9], got %v", got)
+	}
+}
+
+func BenchmarkMinHeap_Build(b *testing.B) {
+	items := make([]int, 100000)
+	for i := range items {
+		items[i] = (i * 7919) % len(items)
+	}
+
+	b.Run("Insert", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			heap := NewMinHeap[int]()
+			for _, item := range items {
+				heap.Insert(item)
+			}
+		}
+	})
+
+	b.Run("NewMinHeapFrom", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NewMinHeapFrom(items)
+		}
+	})
+}
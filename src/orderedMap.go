@@ -0,0 +1,163 @@
+package godatastructures
+
+import "iter"
+
+type orderedEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// OrderedMap is a hash map that remembers the order in which keys were
+// first set, like a LinkedHashMap. A Go map indexes the elements of a
+// LinkedList, so lookups, updates, deletes and moves are all O(1). The zero
+// value is an empty map ready to use. It is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	items map[K]*ListElement[orderedEntry[K, V]]
+	order LinkedList[orderedEntry[K, V]]
+}
+
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{items: make(map[K]*ListElement[orderedEntry[K, V]])}
+}
+
+// OrderedMapFromSeq returns a new map holding the entries of seq, in order.
+func OrderedMapFromSeq[K comparable, V any](seq iter.Seq2[K, V]) *OrderedMap[K, V] {
+	om := NewOrderedMap[K, V]()
+	for key, value := range seq {
+		om.Set(key, value)
+	}
+	return om
+}
+
+func (om *OrderedMap[K, V]) Size() int {
+	return len(om.items)
+}
+
+func (om *OrderedMap[K, V]) IsEmpty() bool {
+	return len(om.items) == 0
+}
+
+func (om *OrderedMap[K, V]) Clear() {
+	om.order.Clear()
+	clear(om.items)
+}
+
+func (om *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if e, ok := om.items[key]; ok {
+		return e.Value.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (om *OrderedMap[K, V]) Contains(key K) bool {
+	_, ok := om.items[key]
+	return ok
+}
+
+// Set stores value under key and reports whether the key was new. A new key
+// goes to the end; an existing key keeps its position.
+func (om *OrderedMap[K, V]) Set(key K, value V) bool {
+	if e, ok := om.items[key]; ok {
+		e.Value.value = value
+		return false
+	}
+	if om.items == nil {
+		om.items = make(map[K]*ListElement[orderedEntry[K, V]])
+	}
+	om.items[key] = om.order.PushBack(orderedEntry[K, V]{key: key, value: value})
+	return true
+}
+
+// Delete removes key and returns its value, if it was present.
+func (om *OrderedMap[K, V]) Delete(key K) (V, bool) {
+	e, ok := om.items[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	delete(om.items, key)
+	entry, _ := om.order.Remove(e)
+	return entry.value, true
+}
+
+// MoveToEnd makes key the newest entry and reports whether it was present.
+func (om *OrderedMap[K, V]) MoveToEnd(key K) bool {
+	e, ok := om.items[key]
+	if ok {
+		om.order.MoveToBack(e)
+	}
+	return ok
+}
+
+// MoveToFront makes key the oldest entry and reports whether it was present.
+func (om *OrderedMap[K, V]) MoveToFront(key K) bool {
+	e, ok := om.items[key]
+	if ok {
+		om.order.MoveToFront(e)
+	}
+	return ok
+}
+
+// Oldest returns the first entry in iteration order.
+func (om *OrderedMap[K, V]) Oldest() (K, V, bool) {
+	return orderedEntryOf(om.order.Front())
+}
+
+// Newest returns the last entry in iteration order.
+func (om *OrderedMap[K, V]) Newest() (K, V, bool) {
+	return orderedEntryOf(om.order.Back())
+}
+
+func orderedEntryOf[K comparable, V any](e *ListElement[orderedEntry[K, V]]) (K, V, bool) {
+	if e == nil {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, false
+	}
+	return e.Value.key, e.Value.value, true
+}
+
+// All yields every entry from oldest to newest.
+func (om *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := om.order.Front(); e != nil; e = e.Next() {
+			if !yield(e.Value.key, e.Value.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields every entry from newest to oldest.
+func (om *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := om.order.Back(); e != nil; e = e.Prev() {
+			if !yield(e.Value.key, e.Value.value) {
+				return
+			}
+		}
+	}
+}
+
+// Keys yields every key from oldest to newest.
+func (om *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range om.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values yields every value from oldest to newest.
+func (om *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range om.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package godatastructures
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap(t *testing.T) {
+	t.Run("Zero value is usable", func(t *testing.T) {
+		var om OrderedMap[string, int]
+		assert.True(t, om.IsEmpty())
+		_, _, ok := om.Oldest()
+		assert.False(t, ok)
+		_, _, ok = om.Newest()
+		assert.False(t, ok)
+
+		assert.True(t, om.Set("a", 1))
+		assert.Equal(t, 1, om.Size())
+	})
+
+	t.Run("Iterates in insertion order", func(t *testing.T) {
+		om := NewOrderedMap[string, int]()
+		om.Set("c", 3)
+		om.Set("a", 1)
+		om.Set("b", 2)
+		assert.False(t, om.Set("c", 30))
+
+		assert.Equal(t, []string{"c", "a", "b"}, slices.Collect(om.Keys()))
+		assert.Equal(t, []int{30, 1, 2}, slices.Collect(om.Values()))
+
+		var backward []string
+		for k := range om.Backward() {
+			backward = append(backward, k)
+		}
+		assert.Equal(t, []string{"b", "a", "c"}, backward)
+
+		key, val, ok := om.Oldest()
+		assert.True(t, ok)
+		assert.Equal(t, "c", key)
+		assert.Equal(t, 30, val)
+		key, _, _ = om.Newest()
+		assert.Equal(t, "b", key)
+	})
+
+	t.Run("Get, delete and re-insert", func(t *testing.T) {
+		om := OrderedMapFromSeq(slices.All([]string{"x", "y", "z"}))
+		val, ok := om.Get(1)
+		assert.True(t, ok)
+		assert.Equal(t, "y", val)
+
+		val, ok = om.Delete(0)
+		assert.True(t, ok)
+		assert.Equal(t, "x", val)
+		_, ok = om.Delete(0)
+		assert.False(t, ok)
+		assert.False(t, om.Contains(0))
+
+		om.Set(0, "x again")
+		assert.Equal(t, []int{1, 2, 0}, slices.Collect(om.Keys()))
+
+		om.Clear()
+		assert.True(t, om.IsEmpty())
+		assert.Empty(t, slices.Collect(om.Keys()))
+	})
+
+	t.Run("Move entries", func(t *testing.T) {
+		om := OrderedMapFromSeq(slices.All([]string{"a", "b", "c"}))
+		assert.True(t, om.MoveToEnd(0))
+		assert.Equal(t, []int{1, 2, 0}, slices.Collect(om.Keys()))
+		assert.True(t, om.MoveToFront(2))
+		assert.Equal(t, []int{2, 1, 0}, slices.Collect(om.Keys()))
+		assert.False(t, om.MoveToEnd(9))
+		assert.False(t, om.MoveToFront(9))
+	})
+}
@@ -0,0 +1,35 @@
+package godatastructures
+
+// OverflowPolicy decides what a bounded container does when an element is
+// added while it is full.
+type OverflowPolicy int
+
+const (
+	// OverflowReject refuses the new element with a *CapacityError.
+	OverflowReject OverflowPolicy = iota
+	// OverflowDropOldest evicts the oldest element to make room. Storage
+	// grows with the number of elements and shrinks again as they are
+	// removed.
+	OverflowDropOldest
+	// OverflowDropNewest silently discards the new element.
+	OverflowDropNewest
+	// OverflowOverwrite makes the container a fixed ring buffer: all
+	// Capacity slots are allocated up front and, once they are full, the new
+	// element is written over the oldest one in place, so adding never
+	// allocates.
+	OverflowOverwrite
+)
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case OverflowReject:
+		return "reject"
+	case OverflowDropOldest:
+		return "drop oldest"
+	case OverflowDropNewest:
+		return "drop newest"
+	case OverflowOverwrite:
+		return "overwrite"
+	}
+	return "unknown"
+}
@@ -1,6 +1,6 @@
 package godatastructures
 
-import "fmt"
+import "iter"
 
 type Node[T any] struct {
 	value T
@@ -11,13 +11,34 @@ type Queue[T any] struct {
 	head *Node[T]
 	size int
 	tail *Node[T]
+	// ring, when set, stores the elements instead of the linked list.
+	ring *Deque[T]
 }
 
 func NewQueue[T any]() *Queue[T] {
 	return &Queue[T]{}
 }
 
+// NewRingQueue returns a queue that stores its elements in a Deque's circular
+// buffer instead of allocating a node per element, which reduces GC pressure
+// on hot paths. It behaves exactly like a queue from NewQueue.
+func NewRingQueue[T any]() *Queue[T] {
+	return &Queue[T]{ring: NewDeque[T]()}
+}
+
+// QueueFromSeq returns a new queue with the elements of seq enqueued in order.
+func QueueFromSeq[T any](seq iter.Seq[T]) *Queue[T] {
+	q := NewQueue[T]()
+	q.Collect(seq)
+	return q
+}
+
 func (q *Queue[T]) Enqueue(item T) {
+	if q.ring != nil {
+		q.ring.PushBack(item)
+		q.size++
+		return
+	}
 	newNode := Node[T]{value: item}
 	if q.size == 0 {
 		q.head = &newNode
@@ -33,7 +54,11 @@ func (q *Queue[T]) Enqueue(item T) {
 func (q *Queue[T]) Dequeue() (T, error) {
 	if q.size <= 0 {
 		var zero T
-		return zero, fmt.Errorf("empty queue, can't dequeue")
+		return zero, emptyError("queue", "dequeue")
+	}
+	if q.ring != nil {
+		q.size--
+		return q.ring.PopFront()
 	}
 	var result T
 	result, q.head = q.head.value, q.head.next
@@ -48,7 +73,10 @@ func (q *Queue[T]) Dequeue() (T, error) {
 func (q *Queue[T]) Peek() (T, error) {
 	if q.size <= 0 {
 		var zero T
-		return zero, fmt.Errorf("empty queue, can't peek")
+		return zero, emptyError("queue", "peek")
+	}
+	if q.ring != nil {
+		return q.ring.Front()
 	}
 	return q.head.value, nil
 }
@@ -62,6 +90,9 @@ func (q *Queue[T]) IsEmpty() bool {
 }
 
 func (q *Queue[T]) Clear() {
+	if q.ring != nil {
+		q.ring.Clear()
+	}
 	q.head = nil
 	q.tail = nil
 	q.size = 0
@@ -70,7 +101,61 @@ func (q *Queue[T]) Clear() {
 func (q *Queue[T]) Rear() (T, error) {
 	if q.size <= 0 {
 		var zero T
-		return zero, fmt.Errorf("empty queue, can't rear")
+		return zero, emptyError("queue", "rear")
+	}
+	if q.ring != nil {
+		return q.ring.Back()
 	}
 	return q.tail.value, nil
 }
+
+// Collect enqueues every element of seq.
+func (q *Queue[T]) Collect(seq iter.Seq[T]) {
+	for item := range seq {
+		q.Enqueue(item)
+	}
+}
+
+// All yields each element from front to rear together with its position.
+// Iteration follows the node links, so elements enqueued during iteration
+// are visited and dequeuing elements already yielded does not affect it.
+// Ring queues follow Deque.All instead.
+func (q *Queue[T]) All() iter.Seq2[int, T] {
+	if q.ring != nil {
+		return q.ring.All()
+	}
+	return func(yield func(int, T) bool) {
+		i := 0
+		for node := q.head; node != nil; node = node.next {
+			if !yield(i, node.value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values yields each element from front to rear, with the same mutation
+// semantics as All.
+func (q *Queue[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range q.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Drain dequeues and yields elements until the queue is empty or the loop
+// stops. Elements enqueued during iteration are drained as well.
+func (q *Queue[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !q.IsEmpty() {
+			item, _ := q.Dequeue()
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
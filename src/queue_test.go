@@ -1,6 +1,7 @@
 package godatastructures
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -218,4 +219,90 @@ func TestQueue(t *testing.T) {
 			t.Errorf("Expected 1.5, got %v", val2)
 		}
 	})
+
+	t.Run("Iterators", func(t *testing.T) {
+		q := QueueFromSeq(slices.Values([]int{1, 2, 3}))
+
+		var seen []int
+		for i, v := range q.All() {
+			if i == 0 {
+				q.Enqueue(4)
+			}
+			seen = append(seen, v)
+		}
+		if !slices.Equal(seen, []int{1, 2, 3, 4}) {
+			t.Errorf("Expected [1 2 3 4] when enqueuing during All, got %v", seen)
+		}
+
+		var drained []int
+		for v := range q.Drain() {
+			drained = append(drained, v)
+			if v == 2 {
+				break
+			}
+		}
+		if !slices.Equal(drained, []int{1, 2}) {
+			t.Errorf("Expected [1 2] drained, got %v", drained)
+		}
+		if got := slices.Collect(q.Values()); !slices.Equal(got, []int{3, 4}) {
+			t.Errorf("Expected remaining [3 4], got %v", got)
+		}
+	})
+
+	t.Run("Ring queue", func(t *testing.T) {
+		q := NewRingQueue[int]()
+
+		_, err := q.Dequeue()
+		if err == nil {
+			t.Error("Expected error when dequeuing from empty ring queue")
+		}
+
+		for i := 0; i < 100; i++ {
+			q.Enqueue(i)
+		}
+		for i := 0; i < 90; i++ {
+			val, err := q.Dequeue()
+			if err != nil || val != i {
+				t.Errorf("Expected %d, got %v with error: %v", i, val, err)
+			}
+		}
+
+		front, _ := q.Peek()
+		rear, _ := q.Rear()
+		if front != 90 || rear != 99 || q.Size() != 10 {
+			t.Errorf("Expected front 90, rear 99 and size 10, got %v, %v and %d", front, rear, q.Size())
+		}
+		if got := slices.Collect(q.Values()); !slices.Equal(got, []int{90, 91, 92, 93, 94, 95, 96, 97, 98, 99}) {
+			t.Errorf("Expected [90 ... 99], got %v", got)
+		}
+
+		q.Clear()
+		if !q.IsEmpty() {
+			t.Error("Ring queue should be empty after clear")
+		}
+		if _, err := q.Peek(); err == nil {
+			t.Error("Expected error when peeking after clear")
+		}
+	})
+}
+
+func BenchmarkQueue(b *testing.B) {
+	implementations := map[string]func() *Queue[int]{
+		"LinkedList": NewQueue[int],
+		"Ring":       NewRingQueue[int],
+	}
+	for name, newQueue := range implementations {
+		b.Run(name, func(b *testing.B) {
+			q := newQueue()
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				q.Enqueue(i)
+				if i%4 == 3 {
+					for j := 0; j < 4; j++ {
+						q.Dequeue()
+					}
+				}
+			}
+		})
+	}
 }
@@ -0,0 +1,185 @@
+package godatastructures
+
+import (
+	"iter"
+	"math/rand/v2"
+)
+
+// skipListMaxLevel bounds the tower height. With a promotion probability of
+// 1/4 it comfortably covers 4^24 entries.
+const skipListMaxLevel = 24
+
+// randomSkipLevel returns a tower height between 1 and skipListMaxLevel,
+// where each extra level has probability 1/4.
+func randomSkipLevel(rng *rand.Rand) int {
+	level := 1
+	for level < skipListMaxLevel && rng.Uint32()&3 == 0 {
+		level++
+	}
+	return level
+}
+
+type skipNode[K, V any] struct {
+	key   K
+	value V
+	next  []*skipNode[K, V]
+}
+
+// SkipList is an ordered map built from a hierarchy of linked lists, where
+// each entry is promoted to higher levels at random. Get, Set and Delete run
+// in expected O(log n). The random source can be seeded so that the shape
+// of the list, and therefore benchmarks and tests, are reproducible.
+type SkipList[K, V any] struct {
+	head    *skipNode[K, V]
+	level   int
+	size    int
+	compare func(a, b K) int
+	rng     *rand.Rand
+}
+
+// NewSkipList returns an empty skip list with a randomly seeded level
+// generator.
+func NewSkipList[K, V any](compare func(a, b K) int) *SkipList[K, V] {
+	return NewSeededSkipList[K, V](compare, rand.Uint64())
+}
+
+// NewSeededSkipList returns an empty skip list whose levels are drawn from a
+// generator seeded with seed.
+func NewSeededSkipList[K, V any](compare func(a, b K) int, seed uint64) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:    &skipNode[K, V]{next: make([]*skipNode[K, V], skipListMaxLevel)},
+		level:   1,
+		compare: compare,
+		rng:     rand.New(rand.NewPCG(seed, seed)),
+	}
+}
+
+func (sl *SkipList[K, V]) Size() int {
+	return sl.size
+}
+
+func (sl *SkipList[K, V]) IsEmpty() bool {
+	return sl.size == 0
+}
+
+func (sl *SkipList[K, V]) Clear() {
+	clear(sl.head.next)
+	sl.level = 1
+	sl.size = 0
+}
+
+func (sl *SkipList[K, V]) Get(key K) (V, bool) {
+	node := sl.seek(key, nil)
+	if node != nil && sl.compare(node.key, key) == 0 {
+		return node.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+func (sl *SkipList[K, V]) Contains(key K) bool {
+	_, ok := sl.Get(key)
+	return ok
+}
+
+// Set stores value under key and reports whether the key was new.
+func (sl *SkipList[K, V]) Set(key K, value V) bool {
+	var update [skipListMaxLevel]*skipNode[K, V]
+	node := sl.seek(key, &update)
+	if node != nil && sl.compare(node.key, key) == 0 {
+		node.value = value
+		return false
+	}
+
+	level := randomSkipLevel(sl.rng)
+	for i := sl.level; i < level; i++ {
+		update[i] = sl.head
+	}
+	sl.level = max(sl.level, level)
+
+	node = &skipNode[K, V]{key: key, value: value, next: make([]*skipNode[K, V], level)}
+	for i := range level {
+		node.next[i] = update[i].next[i]
+		update[i].next[i] = node
+	}
+	sl.size++
+	return true
+}
+
+// Delete removes key and returns its value, if it was present.
+func (sl *SkipList[K, V]) Delete(key K) (V, bool) {
+	var update [skipListMaxLevel]*skipNode[K, V]
+	node := sl.seek(key, &update)
+	if node == nil || sl.compare(node.key, key) != 0 {
+		var zero V
+		return zero, false
+	}
+	for i := range node.next {
+		update[i].next[i] = node.next[i]
+	}
+	for sl.level > 1 && sl.head.next[sl.level-1] == nil {
+		sl.level--
+	}
+	sl.size--
+	return node.value, true
+}
+
+// Min returns the smallest key and its value.
+func (sl *SkipList[K, V]) Min() (K, V, bool) {
+	if first := sl.head.next[0]; first != nil {
+		return first.key, first.value, true
+	}
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
+
+// All yields every entry in ascending key order.
+func (sl *SkipList[K, V]) All() iter.Seq2[K, V] {
+	return sl.iterate(sl.head.next[0])
+}
+
+// Keys yields every key in ascending order.
+func (sl *SkipList[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range sl.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Seek yields the entries with keys greater than or equal to key, in
+// ascending order. Stop ranging to end the scan early.
+func (sl *SkipList[K, V]) Seek(key K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		sl.iterate(sl.seek(key, nil))(yield)
+	}
+}
+
+func (sl *SkipList[K, V]) iterate(start *skipNode[K, V]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for node := start; node != nil; node = node.next[0] {
+			if !yield(node.key, node.value) {
+				return
+			}
+		}
+	}
+}
+
+// seek returns the first node with a key not less than key, or nil. If
+// update is not nil it receives the last node before that point on every
+// level.
+func (sl *SkipList[K, V]) seek(key K, update *[skipListMaxLevel]*skipNode[K, V]) *skipNode[K, V] {
+	node := sl.head
+	for i := sl.level - 1; i >= 0; i-- {
+		for next := node.next[i]; next != nil && sl.compare(next.key, key) < 0; next = node.next[i] {
+			node = next
+		}
+		if update != nil {
+			update[i] = node
+		}
+	}
+	return node.next[0]
+}
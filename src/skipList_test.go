@@ -0,0 +1,189 @@
+package godatastructures
+
+import (
+	"cmp"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSkipList(t *testing.T) {
+	t.Run("Empty list", func(t *testing.T) {
+		sl := NewSkipList[int, string](cmp.Compare[int])
+		assert.True(t, sl.IsEmpty())
+		_, ok := sl.Get(1)
+		assert.False(t, ok)
+		_, ok = sl.Delete(1)
+		assert.False(t, ok)
+		_, _, ok = sl.Min()
+		assert.False(t, ok)
+		for range sl.Seek(0) {
+			t.Error("empty list yielded an entry")
+		}
+	})
+
+	t.Run("Set, get and delete", func(t *testing.T) {
+		sl := NewSkipList[string, int](cmp.Compare[string])
+		assert.True(t, sl.Set("b", 2))
+		assert.True(t, sl.Set("a", 1))
+		assert.False(t, sl.Set("b", 20))
+		assert.Equal(t, 2, sl.Size())
+
+		val, ok := sl.Get("b")
+		assert.True(t, ok)
+		assert.Equal(t, 20, val)
+		key, val, ok := sl.Min()
+		assert.True(t, ok)
+		assert.Equal(t, "a", key)
+		assert.Equal(t, 1, val)
+
+		val, ok = sl.Delete("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+		assert.False(t, sl.Contains("a"))
+
+		sl.Clear()
+		assert.True(t, sl.IsEmpty())
+		assert.Empty(t, slices.Collect(sl.Keys()))
+	})
+
+	t.Run("Seek iterates forward from a key", func(t *testing.T) {
+		sl := NewSkipList[int, int](cmp.Compare[int])
+		for i := range 10 {
+			sl.Set(i*10, i)
+		}
+		var keys []int
+		for k, v := range sl.Seek(35) {
+			assert.Equal(t, k/10, v)
+			keys = append(keys, k)
+			if len(keys) == 3 {
+				break
+			}
+		}
+		assert.Equal(t, []int{40, 50, 60}, keys)
+		assert.Equal(t, []int{90}, slices.Collect(func(yield func(int) bool) {
+			for k := range sl.Seek(90) {
+				if !yield(k) {
+					return
+				}
+			}
+		}))
+	})
+
+	t.Run("Seeded lists have the same shape", func(t *testing.T) {
+		shape := func() []int {
+			sl := NewSeededSkipList[int, int](cmp.Compare[int], 42)
+			for i := range 200 {
+				sl.Set(i, i)
+			}
+			var levels []int
+			for node := sl.head.next[0]; node != nil; node = node.next[0] {
+				levels = append(levels, len(node.next))
+			}
+			return levels
+		}
+		first := shape()
+		assert.Equal(t, first, shape())
+		assert.Greater(t, slices.Max(first), 1)
+	})
+
+	t.Run("Matches a map under random operations", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(7))
+		sl := NewSeededSkipList[int, int](cmp.Compare[int], 7)
+		reference := map[int]int{}
+		for i := range 5000 {
+			key := rng.Intn(500)
+			if rng.Intn(3) == 0 {
+				want, wantOK := reference[key]
+				delete(reference, key)
+				got, gotOK := sl.Delete(key)
+				require.Equal(t, wantOK, gotOK)
+				require.Equal(t, want, got)
+			} else {
+				reference[key] = i
+				sl.Set(key, i)
+			}
+		}
+		assert.Equal(t, len(reference), sl.Size())
+		assert.Equal(t, slices.Sorted(maps.Keys(reference)), slices.Collect(sl.Keys()))
+		for k, v := range sl.All() {
+			assert.Equal(t, reference[k], v)
+		}
+	})
+}
+
+func BenchmarkOrderedMaps(b *testing.B) {
+	const n = 100000
+	keys := make([]int, n)
+	for i := range keys {
+		keys[i] = (i * 7919) % n
+	}
+
+	b.Run("SkipList/Set", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sl := NewSeededSkipList[int, int](cmp.Compare[int], 1)
+			for _, k := range keys {
+				sl.Set(k, k)
+			}
+		}
+	})
+
+	b.Run("ConcurrentSkipList/Set", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sl := NewSeededConcurrentSkipList[int, int](cmp.Compare[int], 1)
+			for _, k := range keys {
+				sl.Set(k, k)
+			}
+		}
+	})
+
+	b.Run("TreeMap/Put", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tm := NewTreeMap[int, int](cmp.Compare[int])
+			for _, k := range keys {
+				tm.Put(k, k)
+			}
+		}
+	})
+
+	sl := NewSeededSkipList[int, int](cmp.Compare[int], 1)
+	csl := NewSeededConcurrentSkipList[int, int](cmp.Compare[int], 1)
+	tm := NewTreeMap[int, int](cmp.Compare[int])
+	for _, k := range keys {
+		sl.Set(k, k)
+		csl.Set(k, k)
+		tm.Put(k, k)
+	}
+
+	b.Run("SkipList/Get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sl.Get(keys[i%n])
+		}
+	})
+
+	b.Run("ConcurrentSkipList/Get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			csl.Get(keys[i%n])
+		}
+	})
+
+	b.Run("ConcurrentSkipList/ParallelGet", func(b *testing.B) {
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				csl.Get(keys[i%n])
+				i++
+			}
+		})
+	})
+
+	b.Run("TreeMap/Get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			tm.Get(keys[i%n])
+		}
+	})
+}
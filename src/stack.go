@@ -1,5 +1,7 @@
 package godatastructures
 
+import "iter"
+
 type Stack[T any] struct {
 	data []T
 }
@@ -8,10 +10,12 @@ func NewStack[T any]() *Stack[T] {
 	return &Stack[T]{}
 }
 
-type Err string
-
-func (err Err) Error() string {
-	return string(err)
+// StackFromSeq returns a new stack with the elements of seq pushed in order,
+// so the last element of seq ends up on top.
+func StackFromSeq[T any](seq iter.Seq[T]) *Stack[T] {
+	s := NewStack[T]()
+	s.Collect(seq)
+	return s
 }
 
 func (s *Stack[T]) Push(item T) {
@@ -21,7 +25,7 @@ func (s *Stack[T]) Push(item T) {
 func (s *Stack[T]) Pop() (T, error) {
 	if len(s.data) <= 0 {
 		var zero T
-		return zero, Err("Empty stack")
+		return zero, emptyError("stack", "pop")
 	}
 	result := s.data[len(s.data)-1]
 	s.data = s.data[:len(s.data)-1]
@@ -32,7 +36,7 @@ func (s *Stack[T]) Pop() (T, error) {
 func (s *Stack[T]) Peek() (T, error) {
 	if len(s.data) <= 0 {
 		var zero T
-		return zero, Err("Empty stack")
+		return zero, emptyError("stack", "peek")
 	}
 	return s.data[len(s.data)-1], nil
 }
@@ -54,3 +58,61 @@ func (s *Stack[T]) downsize() {
 		s.data = newSlice
 	}
 }
+
+// Collect pushes every element of seq onto the stack.
+func (s *Stack[T]) Collect(seq iter.Seq[T]) {
+	for item := range seq {
+		s.Push(item)
+	}
+}
+
+// All yields each element from bottom to top together with its position
+// from the bottom. Elements pushed during iteration are visited and
+// iteration ends early if the stack shrinks.
+func (s *Stack[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := 0; i < len(s.data); i++ {
+			if !yield(i, s.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Values yields each element from bottom to top, with the same mutation
+// semantics as All.
+func (s *Stack[T]) Values() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, item := range s.All() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields each element from top to bottom (pop order) together with
+// its position from the bottom. If the stack shrinks during iteration, it
+// continues from the new top.
+func (s *Stack[T]) Backward() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		for i := len(s.data) - 1; i >= 0; i = min(i, len(s.data)) - 1 {
+			if !yield(i, s.data[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Drain pops and yields elements until the stack is empty or the loop stops.
+// Elements pushed during iteration are popped next.
+func (s *Stack[T]) Drain() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for !s.IsEmpty() {
+			item, _ := s.Pop()
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
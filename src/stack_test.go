@@ -1,6 +1,7 @@
 package godatastructures
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -193,3 +194,34 @@ func BenchmarkStack(b *testing.B) {
 		}
 	})
 }
+
+func TestStackIterators(t *testing.T) {
+	stack := StackFromSeq(slices.Values([]int{1, 2, 3}))
+
+	top, err := stack.Peek()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, top)
+	assert.Equal(t, []int{1, 2, 3}, slices.Collect(stack.Values()))
+
+	var backward []int
+	for i, v := range stack.Backward() {
+		assert.Equal(t, v-1, i)
+		backward = append(backward, v)
+	}
+	assert.Equal(t, []int{3, 2, 1}, backward)
+
+	var drained []int
+	for v := range stack.Drain() {
+		drained = append(drained, v)
+		if v == 3 {
+			stack.Push(4)
+		}
+	}
+	assert.Equal(t, []int{3, 4, 2, 1}, drained)
+	assert.True(t, stack.IsEmpty())
+
+	// Round-tripping through an iterator preserves the stack order
+	stack.Collect(slices.Values([]int{1, 2}))
+	copied := StackFromSeq(stack.Values())
+	assert.Equal(t, slices.Collect(stack.Values()), slices.Collect(copied.Values()))
+}
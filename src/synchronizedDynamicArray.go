@@ -0,0 +1,108 @@
+package godatastructures
+
+import "sync"
+
+// SynchronizedDynamicArray is a DynamicArray guarded by a sync.RWMutex so it
+// can be shared between goroutines. Read-only methods take a read lock.
+type SynchronizedDynamicArray[T any] struct {
+	mu    sync.RWMutex
+	inner *DynamicArray[T]
+}
+
+func NewSynchronizedDynamicArray[T any](size int) *SynchronizedDynamicArray[T] {
+	return &SynchronizedDynamicArray[T]{inner: NewDynamicArray[T](size)}
+}
+
+func (sa *SynchronizedDynamicArray[T]) Append(item T) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	sa.inner.Append(item)
+}
+
+// AppendAll appends items as a single atomic operation.
+func (sa *SynchronizedDynamicArray[T]) AppendAll(items ...T) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	for _, item := range items {
+		sa.inner.Append(item)
+	}
+}
+
+func (sa *SynchronizedDynamicArray[T]) Get(index int) (T, error) {
+	sa.mu.RLock()
+	defer sa.mu.RUnlock()
+	return sa.inner.Get(index)
+}
+
+func (sa *SynchronizedDynamicArray[T]) Set(index int, item T) error {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	return sa.inner.Set(index, item)
+}
+
+func (sa *SynchronizedDynamicArray[T]) Swap(index1, index2 int) error {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	return sa.inner.Swap(index1, index2)
+}
+
+func (sa *SynchronizedDynamicArray[T]) Size() int {
+	sa.mu.RLock()
+	defer sa.mu.RUnlock()
+	return sa.inner.Size()
+}
+
+func (sa *SynchronizedDynamicArray[T]) IsEmpty() bool {
+	sa.mu.RLock()
+	defer sa.mu.RUnlock()
+	return sa.inner.IsEmpty()
+}
+
+func (sa *SynchronizedDynamicArray[T]) Clear() {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	sa.inner.Clear()
+}
+
+func (sa *SynchronizedDynamicArray[T]) String() string {
+	sa.mu.RLock()
+	defer sa.mu.RUnlock()
+	return sa.inner.String()
+}
+
+func (sa *SynchronizedDynamicArray[T]) Pop() (T, error) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	return sa.inner.Pop()
+}
+
+// PopIf removes and returns the last element only if pred accepts it. It
+// returns false if the array is empty or pred rejected the element.
+func (sa *SynchronizedDynamicArray[T]) PopIf(pred func(T) bool) (T, bool) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	last, err := sa.inner.Get(sa.inner.Size() - 1)
+	if err != nil || !pred(last) {
+		var zero T
+		return zero, false
+	}
+	sa.inner.Pop()
+	return last, true
+}
+
+// WithLock runs fn with exclusive access to the underlying array, so several
+// operations can be combined into one transaction. fn must not retain inner
+// or call methods on sa.
+func (sa *SynchronizedDynamicArray[T]) WithLock(fn func(inner *DynamicArray[T])) {
+	sa.mu.Lock()
+	defer sa.mu.Unlock()
+	fn(sa.inner)
+}
+
+// WithReadLock is like WithLock but takes a read lock; fn must not modify
+// inner.
+func (sa *SynchronizedDynamicArray[T]) WithReadLock(fn func(inner *DynamicArray[T])) {
+	sa.mu.RLock()
+	defer sa.mu.RUnlock()
+	fn(sa.inner)
+}
@@ -0,0 +1,77 @@
+package godatastructures
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynchronizedDynamicArray(t *testing.T) {
+	t.Run("Basic operations", func(t *testing.T) {
+		arr := NewSynchronizedDynamicArray[int](0)
+		assert.True(t, arr.IsEmpty())
+
+		arr.AppendAll(1, 2, 3)
+		assert.Nil(t, arr.Set(0, 10))
+		assert.Nil(t, arr.Swap(0, 2))
+		assert.Equal(t, "[3 2 10]", arr.String())
+
+		val, err := arr.Get(1)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, val)
+
+		_, err = arr.Get(3)
+		assert.ErrorIs(t, err, ErrIndexOutOfRange)
+
+		val, err = arr.Pop()
+		assert.Nil(t, err)
+		assert.Equal(t, 10, val)
+		assert.Equal(t, 2, arr.Size())
+
+		arr.Clear()
+		assert.True(t, arr.IsEmpty())
+	})
+
+	t.Run("PopIf", func(t *testing.T) {
+		arr := NewSynchronizedDynamicArray[int](0)
+		isEven := func(v int) bool { return v%2 == 0 }
+
+		_, ok := arr.PopIf(isEven)
+		assert.False(t, ok)
+
+		arr.AppendAll(1, 2)
+		val, ok := arr.PopIf(isEven)
+		assert.True(t, ok)
+		assert.Equal(t, 2, val)
+
+		_, ok = arr.PopIf(isEven)
+		assert.False(t, ok)
+		assert.Equal(t, 1, arr.Size())
+	})
+
+	t.Run("Concurrent appends inside transactions", func(t *testing.T) {
+		arr := NewSynchronizedDynamicArray[int](0)
+		var wg sync.WaitGroup
+		for w := 0; w < 8; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					arr.WithLock(func(inner *DynamicArray[int]) {
+						inner.Append(inner.Size())
+					})
+					arr.Get(0)
+				}
+			}()
+		}
+		wg.Wait()
+
+		arr.WithReadLock(func(inner *DynamicArray[int]) {
+			for i, v := range inner.All() {
+				assert.Equal(t, i, v)
+			}
+		})
+		assert.Equal(t, 800, arr.Size())
+	})
+}
@@ -0,0 +1,88 @@
+package godatastructures
+
+import (
+	"sync"
+
+	"golang.org/x/exp/constraints"
+)
+
+// SynchronizedMinHeap is a MinHeap guarded by a sync.RWMutex so it can be
+// shared between goroutines. Read-only methods take a read lock.
+type SynchronizedMinHeap[T constraints.Ordered] struct {
+	mu    sync.RWMutex
+	inner *MinHeap[T]
+}
+
+func NewSynchronizedMinHeap[T constraints.Ordered]() *SynchronizedMinHeap[T] {
+	return &SynchronizedMinHeap[T]{inner: NewMinHeap[T]()}
+}
+
+func (sh *SynchronizedMinHeap[T]) Insert(item T) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	sh.inner.Insert(item)
+}
+
+// InsertAll inserts items as a single atomic operation.
+func (sh *SynchronizedMinHeap[T]) InsertAll(items ...T) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	for _, item := range items {
+		sh.inner.Insert(item)
+	}
+}
+
+func (sh *SynchronizedMinHeap[T]) Pop() (T, error) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.inner.Pop()
+}
+
+// PopIf pops and returns the minimum only if pred accepts it. It returns
+// false if the heap is empty or pred rejected the element.
+func (sh *SynchronizedMinHeap[T]) PopIf(pred func(T) bool) (T, bool) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	min, err := sh.inner.Peek()
+	if err != nil || !pred(min) {
+		var zero T
+		return zero, false
+	}
+	sh.inner.Pop()
+	return min, true
+}
+
+func (sh *SynchronizedMinHeap[T]) Peek() (T, error) {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.inner.Peek()
+}
+
+func (sh *SynchronizedMinHeap[T]) Size() int {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.inner.Size()
+}
+
+func (sh *SynchronizedMinHeap[T]) IsEmpty() bool {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.inner.IsEmpty()
+}
+
+// WithLock runs fn with exclusive access to the underlying heap, so several
+// operations can be combined into one transaction. fn must not retain inner
+// or call methods on sh.
+func (sh *SynchronizedMinHeap[T]) WithLock(fn func(inner *MinHeap[T])) {
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	fn(sh.inner)
+}
+
+// WithReadLock is like WithLock but takes a read lock; fn must not modify
+// inner.
+func (sh *SynchronizedMinHeap[T]) WithReadLock(fn func(inner *MinHeap[T])) {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	fn(sh.inner)
+}
@@ -0,0 +1,57 @@
+package godatastructures
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynchronizedMinHeap(t *testing.T) {
+	t.Run("Basic operations", func(t *testing.T) {
+		heap := NewSynchronizedMinHeap[int]()
+		heap.InsertAll(5, 3, 8)
+		heap.Insert(1)
+
+		min, err := heap.Peek()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, min)
+
+		belowTwo := func(v int) bool { return v < 2 }
+		val, ok := heap.PopIf(belowTwo)
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+		_, ok = heap.PopIf(belowTwo)
+		assert.False(t, ok)
+
+		val, err = heap.Pop()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, val)
+		assert.Equal(t, 2, heap.Size())
+	})
+
+	t.Run("Concurrent inserts", func(t *testing.T) {
+		heap := NewSynchronizedMinHeap[int]()
+		var wg sync.WaitGroup
+		for w := 0; w < 8; w++ {
+			wg.Add(1)
+			go func(w int) {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					heap.Insert(w*100 + i)
+					heap.Peek()
+				}
+			}(w)
+		}
+		wg.Wait()
+
+		var drained []int
+		heap.WithLock(func(inner *MinHeap[int]) {
+			drained = slices.Collect(inner.Drain())
+		})
+		assert.Equal(t, 800, len(drained))
+		assert.True(t, slices.IsSorted(drained))
+		assert.True(t, heap.IsEmpty())
+	})
+}
@@ -0,0 +1,96 @@
+package godatastructures
+
+import "sync"
+
+// SynchronizedQueue is a Queue guarded by a sync.RWMutex so it can be shared
+// between goroutines. Read-only methods take a read lock.
+type SynchronizedQueue[T any] struct {
+	mu    sync.RWMutex
+	inner *Queue[T]
+}
+
+func NewSynchronizedQueue[T any]() *SynchronizedQueue[T] {
+	return &SynchronizedQueue[T]{inner: NewQueue[T]()}
+}
+
+func (sq *SynchronizedQueue[T]) Enqueue(item T) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	sq.inner.Enqueue(item)
+}
+
+// EnqueueAll enqueues items in order as a single atomic operation.
+func (sq *SynchronizedQueue[T]) EnqueueAll(items ...T) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	for _, item := range items {
+		sq.inner.Enqueue(item)
+	}
+}
+
+func (sq *SynchronizedQueue[T]) Dequeue() (T, error) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	return sq.inner.Dequeue()
+}
+
+// DequeueIf dequeues and returns the front element only if pred accepts it.
+// It returns false if the queue is empty or pred rejected the element.
+func (sq *SynchronizedQueue[T]) DequeueIf(pred func(T) bool) (T, bool) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	front, err := sq.inner.Peek()
+	if err != nil || !pred(front) {
+		var zero T
+		return zero, false
+	}
+	sq.inner.Dequeue()
+	return front, true
+}
+
+func (sq *SynchronizedQueue[T]) Peek() (T, error) {
+	sq.mu.RLock()
+	defer sq.mu.RUnlock()
+	return sq.inner.Peek()
+}
+
+func (sq *SynchronizedQueue[T]) Rear() (T, error) {
+	sq.mu.RLock()
+	defer sq.mu.RUnlock()
+	return sq.inner.Rear()
+}
+
+func (sq *SynchronizedQueue[T]) Size() int {
+	sq.mu.RLock()
+	defer sq.mu.RUnlock()
+	return sq.inner.Size()
+}
+
+func (sq *SynchronizedQueue[T]) IsEmpty() bool {
+	sq.mu.RLock()
+	defer sq.mu.RUnlock()
+	return sq.inner.IsEmpty()
+}
+
+func (sq *SynchronizedQueue[T]) Clear() {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	sq.inner.Clear()
+}
+
+// WithLock runs fn with exclusive access to the underlying queue, so several
+// operations can be combined into one transaction. fn must not retain inner
+// or call methods on sq.
+func (sq *SynchronizedQueue[T]) WithLock(fn func(inner *Queue[T])) {
+	sq.mu.Lock()
+	defer sq.mu.Unlock()
+	fn(sq.inner)
+}
+
+// WithReadLock is like WithLock but takes a read lock; fn must not modify
+// inner.
+func (sq *SynchronizedQueue[T]) WithReadLock(fn func(inner *Queue[T])) {
+	sq.mu.RLock()
+	defer sq.mu.RUnlock()
+	fn(sq.inner)
+}
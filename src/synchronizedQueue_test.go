@@ -0,0 +1,71 @@
+package godatastructures
+
+import (
+	"slices"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynchronizedQueue(t *testing.T) {
+	t.Run("Basic operations", func(t *testing.T) {
+		queue := NewSynchronizedQueue[int]()
+		queue.EnqueueAll(1, 2)
+		queue.Enqueue(3)
+
+		front, err := queue.Peek()
+		assert.Nil(t, err)
+		assert.Equal(t, 1, front)
+		rear, err := queue.Rear()
+		assert.Nil(t, err)
+		assert.Equal(t, 3, rear)
+
+		val, ok := queue.DequeueIf(func(v int) bool { return v == 1 })
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+		_, ok = queue.DequeueIf(func(v int) bool { return v == 1 })
+		assert.False(t, ok)
+
+		val, err = queue.Dequeue()
+		assert.Nil(t, err)
+		assert.Equal(t, 2, val)
+
+		queue.Clear()
+		assert.True(t, queue.IsEmpty())
+		_, ok = queue.DequeueIf(func(int) bool { return true })
+		assert.False(t, ok)
+	})
+
+	t.Run("Concurrent producers and consumers", func(t *testing.T) {
+		queue := NewSynchronizedQueue[int]()
+		var dequeued atomic.Int64
+		var wg sync.WaitGroup
+		for w := 0; w < 8; w++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					queue.Enqueue(i)
+				}
+			}()
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 50; i++ {
+					queue.Size()
+					if _, err := queue.Dequeue(); err == nil {
+						dequeued.Add(1)
+					}
+				}
+			}()
+		}
+		wg.Wait()
+
+		expected := 800 - int(dequeued.Load())
+		queue.WithReadLock(func(inner *Queue[int]) {
+			assert.Equal(t, expected, len(slices.Collect(inner.Values())))
+		})
+		assert.Equal(t, expected, queue.Size())
+	})
+}
@@ -0,0 +1,85 @@
+package godatastructures
+
+import "sync"
+
+// SynchronizedStack is a Stack guarded by a sync.RWMutex so it can be shared
+// between goroutines. Read-only methods take a read lock.
+type SynchronizedStack[T any] struct {
+	mu    sync.RWMutex
+	inner *Stack[T]
+}
+
+func NewSynchronizedStack[T any]() *SynchronizedStack[T] {
+	return &SynchronizedStack[T]{inner: NewStack[T]()}
+}
+
+func (ss *SynchronizedStack[T]) Push(item T) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	ss.inner.Push(item)
+}
+
+// PushAll pushes items in order as a single atomic operation, so the last
+// item ends up on top.
+func (ss *SynchronizedStack[T]) PushAll(items ...T) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	for _, item := range items {
+		ss.inner.Push(item)
+	}
+}
+
+func (ss *SynchronizedStack[T]) Pop() (T, error) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	return ss.inner.Pop()
+}
+
+// PopIf pops and returns the top element only if pred accepts it. It returns
+// false if the stack is empty or pred rejected the element.
+func (ss *SynchronizedStack[T]) PopIf(pred func(T) bool) (T, bool) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	top, err := ss.inner.Peek()
+	if err != nil || !pred(top) {
+		var zero T
+		return zero, false
+	}
+	ss.inner.Pop()
+	return top, true
+}
+
+func (ss *SynchronizedStack[T]) Peek() (T, error) {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	return ss.inner.Peek()
+}
+
+func (ss *SynchronizedStack[T]) Size() int {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	return ss.inner.Size()
+}
+
+func (ss *SynchronizedStack[T]) IsEmpty() bool {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	return ss.inner.IsEmpty()
+}
+
+// WithLock runs fn with exclusive access to the underlying stack, so several
+// operations can be combined into one transaction. fn must not retain inner
+// or call methods on ss.
+func (ss *SynchronizedStack[T]) WithLock(fn func(inner *Stack[T])) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	fn(ss.inner)
+}
+
+// WithReadLock is like WithLock but takes a read lock; fn must not modify
+// inner.
+func (ss *SynchronizedStack[T]) WithReadLock(fn func(inner *Stack[T])) {
+	ss.mu.RLock()
+	defer ss.mu.RUnlock()
+	fn(ss.inner)
+}
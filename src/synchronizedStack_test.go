@@ -0,0 +1,58 @@
+package godatastructures
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSynchronizedStack(t *testing.T) {
+	t.Run("Basic operations", func(t *testing.T) {
+		stack := NewSynchronizedStack[string]()
+		stack.PushAll("a", "b")
+		stack.Push("c")
+		assert.Equal(t, 3, stack.Size())
+
+		top, err := stack.Peek()
+		assert.Nil(t, err)
+		assert.Equal(t, "c", top)
+
+		val, err := stack.Pop()
+		assert.Nil(t, err)
+		assert.Equal(t, "c", val)
+
+		val, ok := stack.PopIf(func(v string) bool { return v == "b" })
+		assert.True(t, ok)
+		assert.Equal(t, "b", val)
+
+		_, ok = stack.PopIf(func(v string) bool { return v == "b" })
+		assert.False(t, ok)
+		assert.False(t, stack.IsEmpty())
+	})
+
+	t.Run("Concurrent push and pop", func(t *testing.T) {
+		stack := NewSynchronizedStack[int]()
+		var wg sync.WaitGroup
+		for w := 0; w < 8; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					stack.PushAll(i, i)
+					stack.Peek()
+					stack.Pop()
+				}
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 800, stack.Size())
+		stack.WithLock(func(inner *Stack[int]) {
+			for !inner.IsEmpty() {
+				inner.Pop()
+			}
+		})
+		assert.True(t, stack.IsEmpty())
+	})
+}
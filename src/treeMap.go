@@ -0,0 +1,401 @@
+package godatastructures
+
+import "iter"
+
+type treeNode[K, V any] struct {
+	key         K
+	value       V
+	left, right *treeNode[K, V]
+	height      int
+	size        int
+}
+
+// TreeMap is an ordered map backed by an AVL tree. Keys are ordered by the
+// comparator given to NewTreeMap, and every node tracks the size of its
+// subtree so Rank and Select run in O(log n) like Get, Put and Delete.
+type TreeMap[K, V any] struct {
+	root    *treeNode[K, V]
+	compare func(a, b K) int
+}
+
+func NewTreeMap[K, V any](compare func(a, b K) int) *TreeMap[K, V] {
+	return &TreeMap[K, V]{compare: compare}
+}
+
+func (tm *TreeMap[K, V]) Size() int {
+	return treeSize(tm.root)
+}
+
+func (tm *TreeMap[K, V]) IsEmpty() bool {
+	return tm.root == nil
+}
+
+func (tm *TreeMap[K, V]) Clear() {
+	tm.root = nil
+}
+
+func (tm *TreeMap[K, V]) Get(key K) (V, bool) {
+	node := tm.root
+	for node != nil {
+		c := tm.compare(key, node.key)
+		switch {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return node.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+func (tm *TreeMap[K, V]) Contains(key K) bool {
+	_, ok := tm.Get(key)
+	return ok
+}
+
+// Put stores value under key and reports whether the key was new.
+func (tm *TreeMap[K, V]) Put(key K, value V) bool {
+	var added bool
+	tm.root = tm.insert(tm.root, key, value, &added)
+	return added
+}
+
+// Delete removes key and returns its value, if it was present.
+func (tm *TreeMap[K, V]) Delete(key K) (V, bool) {
+	var removed *treeNode[K, V]
+	tm.root = tm.remove(tm.root, key, &removed)
+	if removed == nil {
+		var zero V
+		return zero, false
+	}
+	return removed.value, true
+}
+
+// Min returns the smallest key and its value.
+func (tm *TreeMap[K, V]) Min() (K, V, bool) {
+	if tm.root == nil {
+		return tm.none()
+	}
+	node := treeMin(tm.root)
+	return node.key, node.value, true
+}
+
+// Max returns the largest key and its value.
+func (tm *TreeMap[K, V]) Max() (K, V, bool) {
+	node := tm.root
+	if node == nil {
+		return tm.none()
+	}
+	for node.right != nil {
+		node = node.right
+	}
+	return node.key, node.value, true
+}
+
+// Floor returns the largest key less than or equal to key.
+func (tm *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return tm.below(key, true)
+}
+
+// Lower returns the largest key strictly less than key.
+func (tm *TreeMap[K, V]) Lower(key K) (K, V, bool) {
+	return tm.below(key, false)
+}
+
+// Ceiling returns the smallest key greater than or equal to key.
+func (tm *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return tm.above(key, true)
+}
+
+// Higher returns the smallest key strictly greater than key.
+func (tm *TreeMap[K, V]) Higher(key K) (K, V, bool) {
+	return tm.above(key, false)
+}
+
+// Rank returns the number of keys strictly less than key.
+func (tm *TreeMap[K, V]) Rank(key K) int {
+	rank := 0
+	node := tm.root
+	for node != nil {
+		c := tm.compare(key, node.key)
+		if c <= 0 {
+			node = node.left
+		} else {
+			rank += treeSize(node.left) + 1
+			node = node.right
+		}
+	}
+	return rank
+}
+
+// Select returns the key and value at position index in sorted order.
+func (tm *TreeMap[K, V]) Select(index int) (K, V, error) {
+	if index < 0 || index >= tm.Size() {
+		var zeroK K
+		var zeroV V
+		return zeroK, zeroV, indexError("select", index, tm.Size())
+	}
+	node := tm.root
+	for {
+		leftSize := treeSize(node.left)
+		switch {
+		case index < leftSize:
+			node = node.left
+		case index > leftSize:
+			index -= leftSize + 1
+			node = node.right
+		default:
+			return node.key, node.value, nil
+		}
+	}
+}
+
+// All yields every entry in ascending key order.
+func (tm *TreeMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		tm.ascend(tm.root, nil, nil, yield)
+	}
+}
+
+// Keys yields every key in ascending order.
+func (tm *TreeMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for key := range tm.All() {
+			if !yield(key) {
+				return
+			}
+		}
+	}
+}
+
+// Values yields every value in ascending key order.
+func (tm *TreeMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, value := range tm.All() {
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward yields every entry in descending key order.
+func (tm *TreeMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		var stack []*treeNode[K, V]
+		node := tm.root
+		for node != nil || len(stack) > 0 {
+			for node != nil {
+				stack = append(stack, node)
+				node = node.right
+			}
+			node = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(node.key, node.value) {
+				return
+			}
+			node = node.left
+		}
+	}
+}
+
+// Range yields the entries with lo <= key < hi in ascending order.
+func (tm *TreeMap[K, V]) Range(lo, hi K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		tm.ascend(tm.root, &lo, &hi, yield)
+	}
+}
+
+// ascend walks the tree in order, skipping keys below lo and stopping at hi.
+// A nil bound is unbounded.
+func (tm *TreeMap[K, V]) ascend(root *treeNode[K, V], lo, hi *K, yield func(K, V) bool) {
+	var stack []*treeNode[K, V]
+	node := root
+	for node != nil || len(stack) > 0 {
+		for node != nil {
+			if lo != nil && tm.compare(node.key, *lo) < 0 {
+				node = node.right
+				continue
+			}
+			stack = append(stack, node)
+			node = node.left
+		}
+		if len(stack) == 0 {
+			return
+		}
+		node = stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if hi != nil && tm.compare(node.key, *hi) >= 0 {
+			return
+		}
+		if !yield(node.key, node.value) {
+			return
+		}
+		node = node.right
+	}
+}
+
+func (tm *TreeMap[K, V]) below(key K, inclusive bool) (K, V, bool) {
+	var best *treeNode[K, V]
+	node := tm.root
+	for node != nil {
+		c := tm.compare(node.key, key)
+		if c < 0 || (inclusive && c == 0) {
+			best = node
+			node = node.right
+		} else {
+			node = node.left
+		}
+	}
+	if best == nil {
+		return tm.none()
+	}
+	return best.key, best.value, true
+}
+
+func (tm *TreeMap[K, V]) above(key K, inclusive bool) (K, V, bool) {
+	var best *treeNode[K, V]
+	node := tm.root
+	for node != nil {
+		c := tm.compare(node.key, key)
+		if c > 0 || (inclusive && c == 0) {
+			best = node
+			node = node.left
+		} else {
+			node = node.right
+		}
+	}
+	if best == nil {
+		return tm.none()
+	}
+	return best.key, best.value, true
+}
+
+func (tm *TreeMap[K, V]) none() (K, V, bool) {
+	var zeroK K
+	var zeroV V
+	return zeroK, zeroV, false
+}
+
+func (tm *TreeMap[K, V]) insert(node *treeNode[K, V], key K, value V, added *bool) *treeNode[K, V] {
+	if node == nil {
+		*added = true
+		return &treeNode[K, V]{key: key, value: value, height: 1, size: 1}
+	}
+	c := tm.compare(key, node.key)
+	switch {
+	case c < 0:
+		node.left = tm.insert(node.left, key, value, added)
+	case c > 0:
+		node.right = tm.insert(node.right, key, value, added)
+	default:
+		node.value = value
+		return node
+	}
+	return rebalance(node)
+}
+
+func (tm *TreeMap[K, V]) remove(node *treeNode[K, V], key K, removed **treeNode[K, V]) *treeNode[K, V] {
+	if node == nil {
+		return nil
+	}
+	c := tm.compare(key, node.key)
+	switch {
+	case c < 0:
+		node.left = tm.remove(node.left, key, removed)
+	case c > 0:
+		node.right = tm.remove(node.right, key, removed)
+	default:
+		*removed = node
+		if node.left == nil {
+			return node.right
+		}
+		if node.right == nil {
+			return node.left
+		}
+		successor := treeMin(node.right)
+		right := removeMin(node.right)
+		successor.left = node.left
+		successor.right = right
+		node = successor
+	}
+	return rebalance(node)
+}
+
+func removeMin[K, V any](node *treeNode[K, V]) *treeNode[K, V] {
+	if node.left == nil {
+		return node.right
+	}
+	node.left = removeMin(node.left)
+	return rebalance(node)
+}
+
+func treeMin[K, V any](node *treeNode[K, V]) *treeNode[K, V] {
+	for node.left != nil {
+		node = node.left
+	}
+	return node
+}
+
+func treeSize[K, V any](node *treeNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+	return node.size
+}
+
+func treeHeight[K, V any](node *treeNode[K, V]) int {
+	if node == nil {
+		return 0
+	}
+	return node.height
+}
+
+func (node *treeNode[K, V]) update() {
+	node.height = max(treeHeight(node.left), treeHeight(node.right)) + 1
+	node.size = treeSize(node.left) + treeSize(node.right) + 1
+}
+
+func (node *treeNode[K, V]) balance() int {
+	return treeHeight(node.left) - treeHeight(node.right)
+}
+
+// rebalance restores the AVL invariant at node after one of its subtrees
+// changed height by at most one, and returns the new subtree root.
+func rebalance[K, V any](node *treeNode[K, V]) *treeNode[K, V] {
+	node.update()
+	switch b := node.balance(); {
+	case b > 1:
+		if node.left.balance() < 0 {
+			node.left = rotateLeft(node.left)
+		}
+		return rotateRight(node)
+	case b < -1:
+		if node.right.balance() > 0 {
+			node.right = rotateRight(node.right)
+		}
+		return rotateLeft(node)
+	}
+	return node
+}
+
+func rotateLeft[K, V any](node *treeNode[K, V]) *treeNode[K, V] {
+	pivot := node.right
+	node.right = pivot.left
+	pivot.left = node
+	node.update()
+	pivot.update()
+	return pivot
+}
+
+func rotateRight[K, V any](node *treeNode[K, V]) *treeNode[K, V] {
+	pivot := node.left
+	node.left = pivot.right
+	pivot.right = node
+	node.update()
+	pivot.update()
+	return pivot
+}
@@ -0,0 +1,186 @@
+package godatastructures
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// checkAVL verifies ordering, balance, heights and sizes below node.
+func checkAVL[K, V any](t *testing.T, node *treeNode[K, V], compare func(a, b K) int) {
+	t.Helper()
+	if node == nil {
+		return
+	}
+	if node.left != nil {
+		require.Negative(t, compare(node.left.key, node.key))
+	}
+	if node.right != nil {
+		require.Positive(t, compare(node.right.key, node.key))
+	}
+	require.LessOrEqual(t, node.balance(), 1)
+	require.GreaterOrEqual(t, node.balance(), -1)
+	require.Equal(t, max(treeHeight(node.left), treeHeight(node.right))+1, node.height)
+	require.Equal(t, treeSize(node.left)+treeSize(node.right)+1, node.size)
+	checkAVL(t, node.left, compare)
+	checkAVL(t, node.right, compare)
+}
+
+func TestTreeMap(t *testing.T) {
+	t.Run("Empty map", func(t *testing.T) {
+		tm := NewTreeMap[int, string](cmp.Compare[int])
+		assert.True(t, tm.IsEmpty())
+		_, ok := tm.Get(1)
+		assert.False(t, ok)
+		_, _, ok = tm.Min()
+		assert.False(t, ok)
+		_, _, ok = tm.Max()
+		assert.False(t, ok)
+		_, _, ok = tm.Floor(1)
+		assert.False(t, ok)
+		_, _, err := tm.Select(0)
+		assert.ErrorIs(t, err, ErrIndexOutOfRange)
+		_, ok = tm.Delete(1)
+		assert.False(t, ok)
+	})
+
+	t.Run("Put, get and delete", func(t *testing.T) {
+		tm := NewTreeMap[string, int](cmp.Compare[string])
+		assert.True(t, tm.Put("b", 2))
+		assert.True(t, tm.Put("a", 1))
+		assert.False(t, tm.Put("b", 20))
+		assert.Equal(t, 2, tm.Size())
+
+		val, ok := tm.Get("b")
+		assert.True(t, ok)
+		assert.Equal(t, 20, val)
+
+		val, ok = tm.Delete("a")
+		assert.True(t, ok)
+		assert.Equal(t, 1, val)
+		assert.False(t, tm.Contains("a"))
+		assert.Equal(t, 1, tm.Size())
+	})
+
+	t.Run("Navigation", func(t *testing.T) {
+		tm := NewTreeMap[int, int](cmp.Compare[int])
+		for _, k := range []int{10, 20, 30, 40} {
+			tm.Put(k, k*10)
+		}
+		k, v, ok := tm.Floor(25)
+		assert.True(t, ok)
+		assert.Equal(t, 20, k)
+		assert.Equal(t, 200, v)
+		k, _, _ = tm.Floor(20)
+		assert.Equal(t, 20, k)
+		k, _, _ = tm.Lower(20)
+		assert.Equal(t, 10, k)
+		k, _, _ = tm.Ceiling(25)
+		assert.Equal(t, 30, k)
+		k, _, _ = tm.Ceiling(30)
+		assert.Equal(t, 30, k)
+		k, _, _ = tm.Higher(30)
+		assert.Equal(t, 40, k)
+
+		_, _, ok = tm.Lower(10)
+		assert.False(t, ok)
+		_, _, ok = tm.Higher(40)
+		assert.False(t, ok)
+
+		k, _, _ = tm.Min()
+		assert.Equal(t, 10, k)
+		k, _, _ = tm.Max()
+		assert.Equal(t, 40, k)
+	})
+
+	t.Run("Rank and select", func(t *testing.T) {
+		tm := NewTreeMap[int, struct{}](cmp.Compare[int])
+		for _, k := range []int{50, 10, 40, 20, 30} {
+			tm.Put(k, struct{}{})
+		}
+		assert.Equal(t, 0, tm.Rank(5))
+		assert.Equal(t, 2, tm.Rank(30))
+		assert.Equal(t, 3, tm.Rank(35))
+		assert.Equal(t, 5, tm.Rank(99))
+
+		for i, want := range []int{10, 20, 30, 40, 50} {
+			k, _, err := tm.Select(i)
+			assert.Nil(t, err)
+			assert.Equal(t, want, k)
+		}
+		_, _, err := tm.Select(5)
+		assert.ErrorIs(t, err, ErrIndexOutOfRange)
+	})
+
+	t.Run("Iteration and ranges", func(t *testing.T) {
+		tm := NewTreeMap[int, int](cmp.Compare[int])
+		for _, k := range []int{5, 1, 4, 2, 3} {
+			tm.Put(k, -k)
+		}
+		assert.Equal(t, []int{1, 2, 3, 4, 5}, slices.Collect(tm.Keys()))
+		assert.Equal(t, []int{-1, -2, -3, -4, -5}, slices.Collect(tm.Values()))
+
+		var backward []int
+		for k := range tm.Backward() {
+			backward = append(backward, k)
+		}
+		assert.Equal(t, []int{5, 4, 3, 2, 1}, backward)
+
+		var ranged []int
+		for k, v := range tm.Range(2, 5) {
+			assert.Equal(t, -k, v)
+			ranged = append(ranged, k)
+		}
+		assert.Equal(t, []int{2, 3, 4}, ranged)
+
+		ranged = nil
+		for k := range tm.Range(0, 100) {
+			ranged = append(ranged, k)
+			if k == 2 {
+				break
+			}
+		}
+		assert.Equal(t, []int{1, 2}, ranged)
+
+		for range tm.Range(4, 4) {
+			t.Error("empty range yielded an entry")
+		}
+	})
+
+	t.Run("Matches a sorted slice under random operations", func(t *testing.T) {
+		rng := rand.New(rand.NewSource(1))
+		tm := NewTreeMap[int, int](cmp.Compare[int])
+		reference := map[int]int{}
+		for i := range 5000 {
+			key := rng.Intn(500)
+			if rng.Intn(3) == 0 {
+				_, want := reference[key]
+				delete(reference, key)
+				_, got := tm.Delete(key)
+				require.Equal(t, want, got)
+			} else {
+				reference[key] = i
+				tm.Put(key, i)
+			}
+		}
+		checkAVL(t, tm.root, cmp.Compare[int])
+
+		keys := make([]int, 0, len(reference))
+		for k := range reference {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+		assert.Equal(t, keys, slices.Collect(tm.Keys()))
+		for i, k := range keys {
+			assert.Equal(t, i, tm.Rank(k))
+			got, v, err := tm.Select(i)
+			assert.Nil(t, err)
+			assert.Equal(t, k, got)
+			assert.Equal(t, reference[k], v)
+		}
+	})
+}
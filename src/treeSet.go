@@ -0,0 +1,125 @@
+package godatastructures
+
+import "iter"
+
+// TreeSet is an ordered set backed by a TreeMap.
+type TreeSet[T any] struct {
+	tree *TreeMap[T, struct{}]
+}
+
+func NewTreeSet[T any](compare func(a, b T) int) *TreeSet[T] {
+	return &TreeSet[T]{tree: NewTreeMap[T, struct{}](compare)}
+}
+
+// TreeSetFromSeq returns a new set holding the elements of seq.
+func TreeSetFromSeq[T any](seq iter.Seq[T], compare func(a, b T) int) *TreeSet[T] {
+	ts := NewTreeSet(compare)
+	ts.Collect(seq)
+	return ts
+}
+
+func (ts *TreeSet[T]) Size() int {
+	return ts.tree.Size()
+}
+
+func (ts *TreeSet[T]) IsEmpty() bool {
+	return ts.tree.IsEmpty()
+}
+
+func (ts *TreeSet[T]) Clear() {
+	ts.tree.Clear()
+}
+
+// Add inserts item and reports whether it was not already present.
+func (ts *TreeSet[T]) Add(item T) bool {
+	return ts.tree.Put(item, struct{}{})
+}
+
+// Remove deletes item and reports whether it was present.
+func (ts *TreeSet[T]) Remove(item T) bool {
+	_, ok := ts.tree.Delete(item)
+	return ok
+}
+
+func (ts *TreeSet[T]) Contains(item T) bool {
+	return ts.tree.Contains(item)
+}
+
+func (ts *TreeSet[T]) Min() (T, bool) {
+	item, _, ok := ts.tree.Min()
+	return item, ok
+}
+
+func (ts *TreeSet[T]) Max() (T, bool) {
+	item, _, ok := ts.tree.Max()
+	return item, ok
+}
+
+// Floor returns the largest element less than or equal to item.
+func (ts *TreeSet[T]) Floor(item T) (T, bool) {
+	found, _, ok := ts.tree.Floor(item)
+	return found, ok
+}
+
+// Lower returns the largest element strictly less than item.
+func (ts *TreeSet[T]) Lower(item T) (T, bool) {
+	found, _, ok := ts.tree.Lower(item)
+	return found, ok
+}
+
+// Ceiling returns the smallest element greater than or equal to item.
+func (ts *TreeSet[T]) Ceiling(item T) (T, bool) {
+	found, _, ok := ts.tree.Ceiling(item)
+	return found, ok
+}
+
+// Higher returns the smallest element strictly greater than item.
+func (ts *TreeSet[T]) Higher(item T) (T, bool) {
+	found, _, ok := ts.tree.Higher(item)
+	return found, ok
+}
+
+// Rank returns the number of elements strictly less than item.
+func (ts *TreeSet[T]) Rank(item T) int {
+	return ts.tree.Rank(item)
+}
+
+// Select returns the element at position index in sorted order.
+func (ts *TreeSet[T]) Select(index int) (T, error) {
+	item, _, err := ts.tree.Select(index)
+	return item, err
+}
+
+// Collect adds every element of seq to the set.
+func (ts *TreeSet[T]) Collect(seq iter.Seq[T]) {
+	for item := range seq {
+		ts.Add(item)
+	}
+}
+
+// Values yields every element in ascending order.
+func (ts *TreeSet[T]) Values() iter.Seq[T] {
+	return ts.tree.Keys()
+}
+
+// Backward yields every element in descending order.
+func (ts *TreeSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range ts.tree.Backward() {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// Range yields the elements with lo <= item < hi in ascending order.
+func (ts *TreeSet[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range ts.tree.Range(lo, hi) {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
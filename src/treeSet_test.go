@@ -0,0 +1,65 @@
+package godatastructures
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeSet(t *testing.T) {
+	t.Run("Add, remove and contains", func(t *testing.T) {
+		ts := NewTreeSet(cmp.Compare[int])
+		assert.True(t, ts.Add(3))
+		assert.True(t, ts.Add(1))
+		assert.False(t, ts.Add(3))
+		assert.Equal(t, 2, ts.Size())
+		assert.True(t, ts.Contains(1))
+
+		assert.True(t, ts.Remove(1))
+		assert.False(t, ts.Remove(1))
+		assert.Equal(t, []int{3}, slices.Collect(ts.Values()))
+	})
+
+	t.Run("Ordered queries", func(t *testing.T) {
+		ts := TreeSetFromSeq(slices.Values([]int{8, 2, 6, 4}), cmp.Compare[int])
+		minimum, _ := ts.Min()
+		maximum, _ := ts.Max()
+		assert.Equal(t, 2, minimum)
+		assert.Equal(t, 8, maximum)
+
+		floor, _ := ts.Floor(5)
+		lower, _ := ts.Lower(4)
+		ceiling, _ := ts.Ceiling(5)
+		higher, _ := ts.Higher(6)
+		assert.Equal(t, 4, floor)
+		assert.Equal(t, 2, lower)
+		assert.Equal(t, 6, ceiling)
+		assert.Equal(t, 8, higher)
+		_, ok := ts.Higher(8)
+		assert.False(t, ok)
+
+		assert.Equal(t, 2, ts.Rank(6))
+		item, err := ts.Select(3)
+		assert.Nil(t, err)
+		assert.Equal(t, 8, item)
+
+		assert.Equal(t, []int{4, 6}, slices.Collect(ts.Range(3, 8)))
+		assert.Equal(t, []int{8, 6, 4, 2}, slices.Collect(ts.Backward()))
+	})
+
+	t.Run("Custom comparator", func(t *testing.T) {
+		ts := NewTreeSet(func(a, b string) int {
+			return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+		})
+		ts.Add("Banana")
+		ts.Add("apple")
+		assert.False(t, ts.Add("APPLE"))
+		assert.Equal(t, []string{"apple", "Banana"}, slices.Collect(ts.Values()))
+
+		ts.Clear()
+		assert.True(t, ts.IsEmpty())
+	})
+}